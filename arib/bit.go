@@ -0,0 +1,169 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/drillbits/go-ts/ts"
+)
+
+// TableIDBIT is the table_id of BIT.
+const TableIDBIT ts.TableID = 0xC4
+
+// BIT is a Broadcaster Information Table.
+// broadcaster_information_section(){
+//     table_id                     8 uimsbf [0]
+//     section_syntax_indicator     1 bslbf  [1]
+//     reserved_future_use          1 bslbf  [1]
+//     reserved                     2 bslbf  [1]
+//     section_length              12 uimsbf [1-2]
+//     original_network_id         16 uimsbf [3-4]
+//     reserved                     2 bslbf  [5]
+//     version_number               5 uimsbf [5]
+//     current_next_indicator       1 bslbf  [5]
+//     section_number               8 uimsbf [6]
+//     last_section_number          8 uimsbf [7]
+//     reserved_future_use          3 bslbf  [8]
+//     broadcast_view_propriety     1 bslbf  [8]
+//     first_descriptors_length    12 uimsbf [8-9]
+//     for (i=0;i<N;i++){
+//         descriptor()
+//     }
+//     for (j=0;j<N;j++){
+//         broadcaster_id           8 uimsbf
+//         reserved_future_use      4 bslbf
+//         broadcaster_descriptors_length 12 uimsbf
+//         for (k=0;k<N;k++){
+//             descriptor()
+//         }
+//     }
+//     CRC_32                      32 rpchof
+// }
+type BIT ts.PSI
+
+// ToBIT converts the section to the BIT. It returns ErrCRC if the CRC_32
+// does not match.
+func ToBIT(b []byte) (BIT, error) {
+	b, err := section(b, TableIDBIT, "BIT", 10+crc32size)
+	if err != nil {
+		return nil, err
+	}
+	t := BIT(b)
+	pos := 10 + t.FirstDescriptorsLength()
+	end := len(b) - crc32size
+	if pos > end {
+		return nil, fmt.Errorf("arib: first_descriptors_length %d overflows the BIT", t.FirstDescriptorsLength())
+	}
+	if err := checkLoop(b[10:pos], "first descriptors loop of the BIT"); err != nil {
+		return nil, err
+	}
+	for pos < end {
+		if pos+3 > end {
+			return nil, fmt.Errorf("arib: broadcaster loop overflows the BIT at %d", pos)
+		}
+		n := Broadcaster(b[pos:]).DescriptorsLength()
+		if pos+3+n > end {
+			return nil, fmt.Errorf("arib: broadcaster_descriptors_length %d overflows the BIT", n)
+		}
+		if err := checkLoop(b[pos+3:pos+3+n], "broadcaster descriptors loop of the BIT"); err != nil {
+			return nil, err
+		}
+		pos += 3 + n
+	}
+	if err := checkCRC(b); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// OriginalNetworkID returns the OriginalNetworkID.
+func (t BIT) OriginalNetworkID() OriginalNetworkID {
+	return OriginalNetworkID(binary.BigEndian.Uint16(t[3:5]))
+}
+
+// VersionNumber returns the version_number.
+func (t BIT) VersionNumber() int {
+	return ts.VersionNumber(t)
+}
+
+// CurrentNextIndicator returns the current_next_indicator.
+func (t BIT) CurrentNextIndicator() byte {
+	return ts.CurrentNextIndicator(t)
+}
+
+// SectionNumber returns the section_number.
+func (t BIT) SectionNumber() byte {
+	return ts.SectionNumber(t)
+}
+
+// LastSectionNumber returns the last_section_number.
+func (t BIT) LastSectionNumber() byte {
+	return ts.LastSectionNumber(t)
+}
+
+// BroadcastViewPropriety reports whether the broadcast_view_propriety is 1,
+// i.e. the services of each broadcaster should be shown as a unit.
+func (t BIT) BroadcastViewPropriety() bool {
+	return t[8]&0x10>>4 == 1
+}
+
+// FirstDescriptorsLength returns the first_descriptors_length.
+func (t BIT) FirstDescriptorsLength() int {
+	return int(uint16(t[9]&0xFF) | uint16(t[8]&0x0F)<<8)
+}
+
+// Descriptors returns the descriptors of the first loop, e.g.
+// SI_parameter_descriptor.
+func (t BIT) Descriptors() []ts.Descriptor {
+	return ts.Descriptors(t[10 : 10+t.FirstDescriptorsLength()])
+}
+
+// Broadcasters returns the list of Broadcaster.
+func (t BIT) Broadcasters() []Broadcaster {
+	headsize := 3 // broadcaster_id .. broadcaster_descriptors_length
+	var broadcasters []Broadcaster
+	pos := 10 + t.FirstDescriptorsLength()
+	for pos+headsize <= len(t)-crc32size {
+		size := headsize + Broadcaster(t[pos:]).DescriptorsLength()
+		if pos+size > len(t)-crc32size {
+			break
+		}
+		b := Broadcaster(t[pos : pos+size])
+		pos += len(b)
+		broadcasters = append(broadcasters, b)
+	}
+	return broadcasters
+}
+
+// Broadcaster is an information for the broadcaster.
+type Broadcaster []byte
+
+// ID returns the broadcaster_id.
+func (b Broadcaster) ID() byte {
+	return b[0]
+}
+
+// DescriptorsLength returns the broadcaster_descriptors_length.
+func (b Broadcaster) DescriptorsLength() int {
+	return int(uint16(b[2]&0xFF) | uint16(b[1]&0x0F)<<8)
+}
+
+// Descriptors returns the descriptors, e.g. broadcaster_name_descriptor and
+// extended_broadcaster_descriptor.
+func (b Broadcaster) Descriptors() []ts.Descriptor {
+	return ts.Descriptors(b[3:]) // broadcaster_id .. broadcaster_descriptors_length
+}
@@ -0,0 +1,155 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/drillbits/go-ts/ts"
+)
+
+func TestBIT(t *testing.T) {
+	first := []byte{
+		// SI_parameter_descriptor: version 1, 2017-01-01, NIT 10s, SDT 10s
+		0xD7, 0x0A, 0x01, 0xE1, 0x9A, 0x40, 0x01, 0x10, 0x42, 0x02, 0x10, 0x00,
+	}
+	broadcaster := []byte{
+		0x01, 0xF0, 0x00, // broadcaster_id 1
+		// broadcaster_name_descriptor: あい
+		0xD8, 0x02, 0xA2, 0xA4,
+		// extended_broadcaster_descriptor: terrestrial 0x0123, affiliations 1 and 2, broadcaster 0x7FE0-1
+		0xCE, 0x09, 0x1F, 0x01, 0x23, 0x21, 0x01, 0x02, 0x7F, 0xE0, 0x01,
+	}
+	broadcaster[2] = byte(len(broadcaster) - 3)
+	b := []byte{0xC4, 0xF0, 0x00, 0x7F, 0xE0, 0xC3, 0x00, 0x00, 0xE0, byte(len(first))}
+	b = append(b, first...)
+	b = append(b, broadcaster...)
+	b[2] = byte(len(b) - 3 + 4)
+	b = withCRC(b)
+
+	bit, err := ToBIT(b)
+	if err != nil {
+		t.Fatalf("ToBIT(...) => %v", err)
+	}
+	if bit.OriginalNetworkID() != 0x7FE0 || bit.VersionNumber() != 1 || bit.BroadcastViewPropriety() {
+		t.Errorf("OriginalNetworkID(), VersionNumber(), BroadcastViewPropriety() => 0x%04X, %d, %v, want 0x7FE0, 1, false",
+			bit.OriginalNetworkID(), bit.VersionNumber(), bit.BroadcastViewPropriety())
+	}
+
+	ds := bit.Descriptors()
+	if len(ds) != 1 || !IsSIParameterDescriptor(ds[0]) {
+		t.Fatalf("Descriptors() => %X, want SI_parameter_descriptor", ds)
+	}
+	sip := SIParameterDescriptor(ds[0])
+	if want := time.Date(2017, 1, 1, 0, 0, 0, 0, JST); sip.ParameterVersion() != 1 || !sip.UpdateTime(JST).Equal(want) {
+		t.Errorf("ParameterVersion(), UpdateTime(JST) => %d, %v, want 1, %v", sip.ParameterVersion(), sip.UpdateTime(JST), want)
+	}
+	tables := sip.Tables()
+	if len(tables) != 2 {
+		t.Fatalf("Tables() => %d tables, want 2", len(tables))
+	}
+	if c, ok := tables[0].TableCycle(); tables[0].TableID() != 0x40 || !ok || c != 10*time.Second {
+		t.Errorf("Tables()[0] => 0x%02X, %v, %v, want 0x40, 10s, true", tables[0].TableID(), c, ok)
+	}
+	if d := tables[1].TableDescription(); tables[1].TableID() != 0x42 || !bytes.Equal(d, []byte{0x10, 0x00}) {
+		t.Errorf("Tables()[1] => 0x%02X, 0x%X, want 0x42, 0x1000", tables[1].TableID(), d)
+	}
+
+	broadcasters := bit.Broadcasters()
+	if len(broadcasters) != 1 || broadcasters[0].ID() != 1 {
+		t.Fatalf("Broadcasters() => %X, want the broadcaster 1", broadcasters)
+	}
+	ds = broadcasters[0].Descriptors()
+	if len(ds) != 2 || !IsBroadcasterNameDescriptor(ds[0]) || !IsExtendedBroadcasterDescriptor(ds[1]) {
+		t.Fatalf("Broadcasters()[0].Descriptors() => %X, want broadcaster_name_descriptor and extended_broadcaster_descriptor", ds)
+	}
+	if name := BroadcasterNameDescriptor(ds[0]).Name().String(); name != "あい" {
+		t.Errorf("Name() => %s, want あい", name)
+	}
+	ebd := ExtendedBroadcasterDescriptor(ds[1])
+	if ebd.BroadcasterType() != BroadcasterTypeTerrestrial || ebd.TerrestrialBroadcasterID() != 0x0123 {
+		t.Errorf("BroadcasterType(), TerrestrialBroadcasterID() => %d, 0x%04X, want 1, 0x0123", ebd.BroadcasterType(), ebd.TerrestrialBroadcasterID())
+	}
+	if ids := ebd.AffiliationIDs(); !bytes.Equal(ids, []byte{0x01, 0x02}) {
+		t.Errorf("AffiliationIDs() => %X, want 0102", ids)
+	}
+	refs := ebd.Broadcasters()
+	if len(refs) != 1 || refs[0].OriginalNetworkID() != 0x7FE0 || refs[0].BroadcasterID() != 1 {
+		t.Errorf("Broadcasters() => %X, want 7FE001", refs)
+	}
+	if p := ebd.PrivateDataBytes(); len(p) != 0 {
+		t.Errorf("PrivateDataBytes() => %X, want empty", p)
+	}
+
+	b[len(b)-1] ^= 0x01
+	if _, err := ToBIT(b); err != ErrCRC {
+		t.Errorf("ToBIT(...) => %v, want %v", err, ErrCRC)
+	}
+	if _, err := ToBIT(b[:8]); err == nil {
+		t.Errorf("ToBIT(0x%X) => nil, want error", b[:8])
+	}
+}
+
+func TestBITTruncated(t *testing.T) {
+	for i, tc := range []struct {
+		first       []byte
+		broadcaster []byte
+	}{
+		// descriptor_length overflows the first loop
+		{[]byte{0xD7, 0x0B, 0x01, 0xE1, 0x9A, 0x40, 0x01, 0x10, 0x42, 0x02, 0x10, 0x00}, nil},
+		// broadcaster_descriptors_length overflows the section
+		{nil, []byte{0x01, 0xF0, 0x05, 0xD8, 0x02, 0xA2}},
+		// descriptor_length overflows the broadcaster loop
+		{nil, []byte{0x01, 0xF0, 0x03, 0xD8, 0x02, 0xA2, 0x02, 0xF0, 0x00}},
+		// broadcaster loop is cut in the header
+		{nil, []byte{0x01, 0xF0}},
+	} {
+		b := []byte{0xC4, 0xF0, 0x00, 0x7F, 0xE0, 0xC3, 0x00, 0x00, 0xE0, byte(len(tc.first))}
+		b = append(b, tc.first...)
+		b = append(b, tc.broadcaster...)
+		b[2] = byte(len(b) - 3 + 4)
+		b = withCRC(b)
+		if _, err := ToBIT(b); err == nil {
+			t.Errorf("%d: ToBIT(0x%X) => nil, want error", i, b)
+		}
+	}
+}
+
+func TestToSIParameterDescriptor(t *testing.T) {
+	for i, tc := range []struct {
+		d  ts.Descriptor
+		ok bool
+	}{
+		{ts.Descriptor{0xD7, 0x0A, 0x01, 0xE1, 0x9A, 0x40, 0x01, 0x10, 0x42, 0x02, 0x10, 0x00}, true},
+		{ts.Descriptor{0xD7, 0x03, 0x01, 0xE1, 0x9A}, true},
+		// table_description_length overflows the descriptor
+		{ts.Descriptor{0xD7, 0x08, 0x01, 0xE1, 0x9A, 0x40, 0x01, 0x10, 0x42, 0x02}, false},
+		{ts.Descriptor{0xD7, 0x02, 0x01, 0xE1}, false},
+		{ts.Descriptor{0xD8, 0x03, 0x01, 0xE1, 0x9A}, false},
+	} {
+		if _, err := ToSIParameterDescriptor(tc.d); (err == nil) != tc.ok {
+			t.Errorf("%d: ToSIParameterDescriptor(0x%X) => %v, want ok %t", i, []byte(tc.d), err, tc.ok)
+		}
+	}
+}
+
+func TestExtendedBroadcasterDescriptorReserved(t *testing.T) {
+	d := ExtendedBroadcasterDescriptor{0xCE, 0x02, 0x3F, 0x00}
+	if d.IsTerrestrial() || d.TerrestrialBroadcasterID() != 0 || d.AffiliationIDs() != nil || d.Broadcasters() != nil {
+		t.Errorf("ExtendedBroadcasterDescriptor(0x%X) has terrestrial IDs, want none", []byte(d))
+	}
+}
@@ -0,0 +1,311 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image/color"
+
+	"github.com/drillbits/go-ts/ts"
+)
+
+// TableIDCDT is the table_id of CDT.
+const TableIDCDT ts.TableID = 0xC8
+
+// DataTypeLogo is the data_type of the logo data.
+const DataTypeLogo = 0x01
+
+// CDT is a Common Data Table.
+// common_data_section(){
+//     table_id                     8 uimsbf [0]
+//     section_syntax_indicator     1 bslbf  [1]
+//     reserved_future_use          1 bslbf  [1]
+//     reserved                     2 bslbf  [1]
+//     section_length              12 uimsbf [1-2]
+//     download_data_id            16 uimsbf [3-4]
+//     reserved                     2 bslbf  [5]
+//     version_number               5 uimsbf [5]
+//     current_next_indicator       1 bslbf  [5]
+//     section_number               8 uimsbf [6]
+//     last_section_number          8 uimsbf [7]
+//     original_network_id         16 uimsbf [8-9]
+//     data_type                    8 uimsbf [10]
+//     reserved_future_use          4 bslbf  [11]
+//     descriptors_loop_length     12 uimsbf [11-12]
+//     for (i=0;i<N;i++){
+//         descriptor()
+//     }
+//     for (j=0;j<M;j++){
+//         data_module_byte         8 bslbf
+//     }
+//     CRC_32                      32 rpchof
+// }
+type CDT ts.PSI
+
+// ToCDT converts the section to the CDT. It returns ErrCRC if the CRC_32
+// does not match.
+func ToCDT(b []byte) (CDT, error) {
+	b, err := section(b, TableIDCDT, "CDT", 13+crc32size)
+	if err != nil {
+		return nil, err
+	}
+	t := CDT(b)
+	if 13+t.DescriptorsLoopLength()+crc32size > len(b) {
+		return nil, fmt.Errorf("arib: descriptors_loop_length %d overflows the CDT", t.DescriptorsLoopLength())
+	}
+	if err := checkCRC(b); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DownloadDataID returns the download_data_id.
+func (t CDT) DownloadDataID() uint16 {
+	return binary.BigEndian.Uint16(t[3:5])
+}
+
+// VersionNumber returns the version_number.
+func (t CDT) VersionNumber() int {
+	return ts.VersionNumber(t)
+}
+
+// CurrentNextIndicator returns the current_next_indicator.
+func (t CDT) CurrentNextIndicator() byte {
+	return ts.CurrentNextIndicator(t)
+}
+
+// SectionNumber returns the section_number.
+func (t CDT) SectionNumber() byte {
+	return ts.SectionNumber(t)
+}
+
+// LastSectionNumber returns the last_section_number.
+func (t CDT) LastSectionNumber() byte {
+	return ts.LastSectionNumber(t)
+}
+
+// OriginalNetworkID returns the OriginalNetworkID.
+func (t CDT) OriginalNetworkID() OriginalNetworkID {
+	return OriginalNetworkID(binary.BigEndian.Uint16(t[8:10]))
+}
+
+// DataType returns the data_type.
+func (t CDT) DataType() byte {
+	return t[10]
+}
+
+// DescriptorsLoopLength returns the descriptors_loop_length.
+func (t CDT) DescriptorsLoopLength() int {
+	return int(uint16(t[12]&0xFF) | uint16(t[11]&0x0F)<<8)
+}
+
+// Descriptors returns the descriptors.
+func (t CDT) Descriptors() []ts.Descriptor {
+	return ts.Descriptors(t[13 : 13+t.DescriptorsLoopLength()])
+}
+
+// DataModule returns the data_module_bytes.
+func (t CDT) DataModule() []byte {
+	return t[13+t.DescriptorsLoopLength() : len(t)-crc32size]
+}
+
+// LogoData is the data module of the logo data.
+// logo_data(){
+//     logo_type                    8 uimsbf [0]
+//     reserved_future_use          7 bslbf  [1]
+//     logo_id                      9 uimsbf [1-2]
+//     reserved_future_use          4 bslbf  [3]
+//     logo_version                12 uimsbf [3-4]
+//     data_size                   16 uimsbf [5-6]
+//     for (i=0;i<N;i++){
+//         data_byte                8 bslbf
+//     }
+// }
+type LogoData []byte
+
+// LogoData returns the data module as the logo data.
+func (t CDT) LogoData() (LogoData, error) {
+	if t.DataType() != DataTypeLogo {
+		return nil, fmt.Errorf("arib: data_type 0x%02X of the CDT is not logo data", t.DataType())
+	}
+	m := LogoData(t.DataModule())
+	if len(m) < 7 || 7+m.DataSize() > len(m) {
+		return nil, errors.New("arib: logo data is too short")
+	}
+	return m, nil
+}
+
+// LogoType returns the logo_type.
+func (m LogoData) LogoType() byte {
+	return m[0]
+}
+
+// LogoID returns the logo_id.
+func (m LogoData) LogoID() uint16 {
+	return uint16(m[1]&0x01)<<8 | uint16(m[2])
+}
+
+// LogoVersion returns the logo_version.
+func (m LogoData) LogoVersion() uint16 {
+	return uint16(m[3]&0x0F)<<8 | uint16(m[4])
+}
+
+// DataSize returns the data_size.
+func (m LogoData) DataSize() int {
+	return int(binary.BigEndian.Uint16(m[5:7]))
+}
+
+// Data returns the data_bytes, which is a PNG without the palette.
+func (m LogoData) Data() []byte {
+	return m[7 : 7+m.DataSize()]
+}
+
+// LogoKey identifies a logo.
+type LogoKey struct {
+	OriginalNetworkID OriginalNetworkID
+	LogoID            uint16
+	LogoType          byte
+}
+
+// Logo is a station logo.
+type Logo struct {
+	LogoKey
+	LogoVersion    uint16
+	DownloadDataID uint16
+
+	// PNG is the paletted PNG with CommonCLUT.
+	PNG []byte
+}
+
+// Logo returns the logo in the CDT.
+func (t CDT) Logo() (*Logo, error) {
+	m, err := t.LogoData()
+	if err != nil {
+		return nil, err
+	}
+	b, err := PalettedPNG(m.Data())
+	if err != nil {
+		return nil, err
+	}
+	return &Logo{
+		LogoKey: LogoKey{
+			OriginalNetworkID: t.OriginalNetworkID(),
+			LogoID:            m.LogoID(),
+			LogoType:          m.LogoType(),
+		},
+		LogoVersion:    m.LogoVersion(),
+		DownloadDataID: t.DownloadDataID(),
+		PNG:            b,
+	}, nil
+}
+
+// Logos returns the logos in the CDTs by the keys. The CDTs whose data are
+// not logos are skipped, and so are the malformed ones, whose errors are
+// joined and returned with the other logos. The logo of the highest
+// logo_version is kept for each key, or the later one of the same version.
+func Logos(cdts []CDT) (map[LogoKey]*Logo, error) {
+	logos := make(map[LogoKey]*Logo)
+	var errs []error
+	for _, t := range cdts {
+		if t.DataType() != DataTypeLogo {
+			continue
+		}
+		l, err := t.Logo()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if old, ok := logos[l.LogoKey]; ok && old.LogoVersion > l.LogoVersion {
+			continue
+		}
+		logos[l.LogoKey] = l
+	}
+	return logos, errors.Join(errs...)
+}
+
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// PalettedPNG returns the PNG of the indexed colors with the palette of
+// CommonCLUT instead of its own PLTE and tRNS chunks, if any. The palette has
+// the first 1<<depth colors of CommonCLUT, so an image of 8-bit depth has the
+// 128 colors of CommonCLUT and its indices from 128 are out of the palette.
+func PalettedPNG(b []byte) ([]byte, error) {
+	if len(b) < len(pngSignature) || string(b[:len(pngSignature)]) != pngSignature {
+		return nil, errors.New("arib: not a PNG")
+	}
+	out := make([]byte, 0, len(b)+3*len(CommonCLUT)+len(CommonCLUT)+2*12)
+	out = append(out, pngSignature...)
+	for pos := len(pngSignature); pos < len(b); {
+		if pos+12 > len(b) {
+			return nil, errors.New("arib: PNG chunk is too short")
+		}
+		size := 12 + int(binary.BigEndian.Uint32(b[pos:pos+4])) // length .. CRC
+		if size < 12 || pos+size > len(b) {
+			return nil, errors.New("arib: PNG chunk is too short")
+		}
+		chunk := b[pos : pos+size]
+		pos += size
+
+		switch string(chunk[4:8]) {
+		case "PLTE", "tRNS":
+			continue
+		case "IHDR":
+			if size < 12+13 {
+				return nil, errors.New("arib: PNG IHDR is too short")
+			}
+			depth, colorType := chunk[16], chunk[17]
+			if colorType != 3 {
+				return nil, fmt.Errorf("arib: PNG color type %d is not indexed", colorType)
+			}
+			if depth != 1 && depth != 2 && depth != 4 && depth != 8 {
+				return nil, fmt.Errorf("arib: PNG bit depth %d is invalid for indexed colors", depth)
+			}
+			out = append(out, chunk...)
+			out = appendCLUT(out, 1<<depth)
+			continue
+		}
+		out = append(out, chunk...)
+	}
+	return out, nil
+}
+
+// appendCLUT appends the PLTE and tRNS chunks of the first n colors of
+// CommonCLUT.
+func appendCLUT(b []byte, n int) []byte {
+	if n > len(CommonCLUT) {
+		n = len(CommonCLUT)
+	}
+	plte := make([]byte, 0, 3*n)
+	trns := make([]byte, 0, n)
+	for _, c := range CommonCLUT[:n] {
+		c := color.NRGBAModel.Convert(c).(color.NRGBA)
+		plte = append(plte, c.R, c.G, c.B)
+		trns = append(trns, c.A)
+	}
+	b = appendChunk(b, "PLTE", plte)
+	return appendChunk(b, "tRNS", trns)
+}
+
+// appendChunk appends the PNG chunk of the type and the data.
+func appendChunk(b []byte, typ string, data []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(data)))
+	n := len(b)
+	b = append(b, typ...)
+	b = append(b, data...)
+	return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b[n:]))
+}
@@ -0,0 +1,218 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestCommonCLUT(t *testing.T) {
+	if len(CommonCLUT) != 128 {
+		t.Fatalf("len(CommonCLUT) => %d, want 128", len(CommonCLUT))
+	}
+	for i, want := range map[int]color.NRGBA{
+		0:   {0, 0, 0, 255},
+		3:   {255, 255, 0, 255},
+		7:   {255, 255, 255, 255},
+		8:   {0, 0, 0, 0},
+		9:   {170, 0, 0, 255},
+		15:  {170, 170, 170, 255},
+		16:  {0, 0, 85, 255},
+		17:  {0, 85, 0, 255},
+		25:  {85, 0, 0, 255},
+		63:  {255, 255, 85, 255},
+		64:  {255, 255, 170, 255},
+		65:  {0, 0, 0, 128},
+		72:  {255, 255, 255, 128},
+		73:  {170, 0, 0, 128},
+		80:  {0, 0, 85, 128},
+		127: {255, 255, 85, 128},
+	} {
+		if got := CommonCLUT[i]; got != want {
+			t.Errorf("CommonCLUT[%d] => %v, want %v", i, got, want)
+		}
+	}
+}
+
+// aribPNG returns the PNG of the indices without the palette, as the logo
+// data.
+func aribPNG(t *testing.T, pix []uint8, w, h int) []byte {
+	img := image.NewPaletted(image.Rect(0, 0, w, h), CommonCLUT)
+	copy(img.Pix, pix)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	out := append([]byte(nil), b[:8]...)
+	for pos := 8; pos < len(b); {
+		size := 12 + int(binary.BigEndian.Uint32(b[pos:]))
+		if typ := string(b[pos+4 : pos+8]); typ != "PLTE" && typ != "tRNS" {
+			out = append(out, b[pos:pos+size]...)
+		}
+		pos += size
+	}
+	return out
+}
+
+func TestPalettedPNG(t *testing.T) {
+	pix := []uint8{0, 1, 8, 15, 64, 65, 100, 127}
+	b, err := PalettedPNG(aribPNG(t, pix, 4, 2))
+	if err != nil {
+		t.Fatalf("PalettedPNG(...) => %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("png.Decode(PalettedPNG(...)) => %v", err)
+	}
+	p, ok := img.(*image.Paletted)
+	if !ok {
+		t.Fatalf("png.Decode(PalettedPNG(...)) => %T, want *image.Paletted", img)
+	}
+	if !bytes.Equal(p.Pix, pix) {
+		t.Errorf("Pix => %v, want %v", p.Pix, pix)
+	}
+	if len(p.Palette) != len(CommonCLUT) {
+		t.Errorf("len(Palette) => %d, want %d", len(p.Palette), len(CommonCLUT))
+	}
+	for i, c := range p.Palette {
+		if got := color.NRGBAModel.Convert(c); got != CommonCLUT[i] {
+			t.Errorf("Palette[%d] => %v, want %v", i, got, CommonCLUT[i])
+		}
+	}
+
+	for i, b := range [][]byte{
+		nil,
+		[]byte("GIF89a"),
+		[]byte(pngSignature + "\x00\x00\x00\x0DIHDR"),
+		[]byte(pngSignature + "\x00\x00\x00\x0DIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x10\x03\x00\x00\x00\x00\x00\x00\x00"),
+		[]byte(pngSignature + "\x00\x00\x00\x0DIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x02\x00\x00\x00\x00\x00\x00\x00"),
+	} {
+		if _, err := PalettedPNG(b); err == nil {
+			t.Errorf("%d: PalettedPNG(%q) => nil, want error", i, b)
+		}
+	}
+}
+
+// logoCDT returns the CDT of the logo of logo_id 0x0123 and logo_type 5 on
+// the original_network_id 4.
+func logoCDT(t *testing.T, version uint16, data []byte) CDT {
+	module := []byte{0x05, 0xFF, 0x23, 0xF0 | byte(version>>8), byte(version), byte(len(data) >> 8), byte(len(data))}
+	module = append(module, data...)
+	b := []byte{0xC8, 0xF0, 0x00, 0x00, 0x10, 0xC5, 0x00, 0x00, 0x00, 0x04, 0x01, 0xF0, 0x00}
+	b = append(b, module...)
+	b[1], b[2] = 0xF0|byte((len(b)+1)>>8), byte(len(b)+1)
+	cdt, err := ToCDT(withCRC(b))
+	if err != nil {
+		t.Fatalf("ToCDT(...) => %v", err)
+	}
+	return cdt
+}
+
+func TestCDTLogo(t *testing.T) {
+	cdt := logoCDT(t, 2, aribPNG(t, []uint8{1, 2, 3, 4}, 2, 2))
+	if cdt.DownloadDataID() != 0x0010 || cdt.VersionNumber() != 2 || cdt.OriginalNetworkID() != 0x0004 || cdt.DataType() != DataTypeLogo {
+		t.Errorf("DownloadDataID(), VersionNumber(), OriginalNetworkID(), DataType() => 0x%04X, %d, 0x%04X, %d, want 0x0010, 2, 0x0004, 1",
+			cdt.DownloadDataID(), cdt.VersionNumber(), cdt.OriginalNetworkID(), cdt.DataType())
+	}
+
+	logos, err := Logos([]CDT{cdt})
+	if err != nil {
+		t.Fatalf("Logos(...) => %v", err)
+	}
+	key := LogoKey{OriginalNetworkID: 0x0004, LogoID: 0x0123, LogoType: 0x05}
+	l, ok := logos[key]
+	if !ok || len(logos) != 1 {
+		t.Fatalf("Logos(...) => %v, want the logo of %v", logos, key)
+	}
+	if l.LogoVersion != 2 || l.DownloadDataID != 0x0010 {
+		t.Errorf("LogoVersion, DownloadDataID => %d, 0x%04X, want 2, 0x0010", l.LogoVersion, l.DownloadDataID)
+	}
+	img, err := png.Decode(bytes.NewReader(l.PNG))
+	if err != nil {
+		t.Fatalf("png.Decode(PNG) => %v", err)
+	}
+	if got := color.NRGBAModel.Convert(img.At(1, 0)); got != CommonCLUT[2] {
+		t.Errorf("At(1, 0) => %v, want %v", got, CommonCLUT[2])
+	}
+}
+
+func TestLogoTransmissionDescriptor(t *testing.T) {
+	t.Parallel()
+
+	for i, tc := range []struct {
+		d              LogoTransmissionDescriptor
+		id, version    uint16
+		downloadDataID uint16
+		simpleLogo     string
+	}{
+		{LogoTransmissionDescriptor{0xCF, 0x07, 0x01, 0xFF, 0x23, 0xF0, 0x02, 0x00, 0x10}, 0x0123, 2, 0x0010, ""},
+		{LogoTransmissionDescriptor{0xCF, 0x03, 0x02, 0xFE, 0x45}, 0x0045, 0, 0, ""},
+		{LogoTransmissionDescriptor{0xCF, 0x03, 0x03, 0xA2, 0xA4}, 0, 0, 0, "あい"},
+		{LogoTransmissionDescriptor{0xCF, 0x01, 0x04}, 0, 0, 0, ""},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.d.LogoID(); got != tc.id {
+				t.Errorf("%d: LogoID() => 0x%04X, want 0x%04X", i, got, tc.id)
+			}
+			if got := tc.d.LogoVersion(); got != tc.version {
+				t.Errorf("%d: LogoVersion() => %d, want %d", i, got, tc.version)
+			}
+			if got := tc.d.DownloadDataID(); got != tc.downloadDataID {
+				t.Errorf("%d: DownloadDataID() => 0x%04X, want 0x%04X", i, got, tc.downloadDataID)
+			}
+			if got := tc.d.SimpleLogo().String(); got != tc.simpleLogo {
+				t.Errorf("%d: SimpleLogo() => %s, want %s", i, got, tc.simpleLogo)
+			}
+		})
+	}
+}
+
+func TestLogos(t *testing.T) {
+	key := LogoKey{OriginalNetworkID: 0x0004, LogoID: 0x0123, LogoType: 0x05}
+	data := aribPNG(t, []uint8{1, 2, 3, 4}, 2, 2)
+	for i, tc := range []struct {
+		versions []uint16
+		bad      bool
+		want     uint16
+	}{
+		{[]uint16{1, 2}, false, 2},
+		{[]uint16{2, 1}, false, 2},
+		{[]uint16{3}, true, 3},
+	} {
+		var cdts []CDT
+		for _, v := range tc.versions {
+			cdts = append(cdts, logoCDT(t, v, data))
+		}
+		if tc.bad {
+			cdts = append(cdts, logoCDT(t, 4, []byte("GIF89a")))
+		}
+		logos, err := Logos(cdts)
+		if (err != nil) != tc.bad {
+			t.Errorf("%d: Logos(...) => %v, want error %v", i, err, tc.bad)
+		}
+		if l, ok := logos[key]; !ok || l.LogoVersion != tc.want {
+			t.Errorf("%d: Logos(...) => %v, want the logo of version %d", i, logos, tc.want)
+		}
+	}
+}
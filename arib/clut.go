@@ -0,0 +1,82 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"image/color"
+)
+
+// CommonCLUT is the common fixed color table of ARIB STD-B21, the 128
+// colors of the palettes 0 to 7 of captions. The colors are:
+//
+//	0-7:    black, red, green, yellow, blue, magenta, cyan and white
+//	8:      transparent
+//	9-15:   the colors of 1 to 7 in the level 170
+//	16-64:  the other colors of the levels 0, 85, 170 and 255 in order of
+//	        red, green and blue
+//	65-127: the colors of 0 to 7 and 9 to 63 in the half transparency
+var CommonCLUT = commonCLUT()
+
+func commonCLUT() color.Palette {
+	var p color.Palette
+	primaries := func(level uint8) {
+		for i := 0; i < 8; i++ {
+			c := color.NRGBA{A: 0xFF}
+			if i&1 != 0 {
+				c.R = level
+			}
+			if i&2 != 0 {
+				c.G = level
+			}
+			if i&4 != 0 {
+				c.B = level
+			}
+			p = append(p, c)
+		}
+	}
+	primaries(255)
+	primaries(170)
+	p[8] = color.NRGBA{} // transparent instead of black
+
+	levels := []uint8{0, 85, 170, 255}
+	only := func(c color.NRGBA, a, b uint8) bool {
+		for _, v := range []uint8{c.R, c.G, c.B} {
+			if v != a && v != b {
+				return false
+			}
+		}
+		return true
+	}
+	for _, r := range levels {
+		for _, g := range levels {
+			for _, b := range levels {
+				c := color.NRGBA{r, g, b, 0xFF}
+				if !only(c, 0, 255) && !only(c, 0, 170) {
+					p = append(p, c)
+				}
+			}
+		}
+	}
+
+	for i := 0; i < 64; i++ {
+		if i == 8 {
+			continue
+		}
+		c := p[i].(color.NRGBA)
+		c.A = 0x80
+		p = append(p, c)
+	}
+	return p
+}
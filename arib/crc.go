@@ -0,0 +1,56 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import "errors"
+
+// ErrCRC is the error of a section whose CRC_32 does not match.
+var ErrCRC = errors.New("arib: CRC_32 mismatch")
+
+// crcTable is the table of the CRC-32 of MPEG-2, whose polynomial is
+// 0x04C11DB7 without reflection.
+var crcTable = func() [256]uint32 {
+	var t [256]uint32
+	for i := range t {
+		c := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if c&0x80000000 != 0 {
+				c = c<<1 ^ 0x04C11DB7
+			} else {
+				c <<= 1
+			}
+		}
+		t[i] = c
+	}
+	return t
+}()
+
+// mpegCRC32 returns the CRC-32 of MPEG-2 of b.
+func mpegCRC32(b []byte) uint32 {
+	c := uint32(0xFFFFFFFF)
+	for _, v := range b {
+		c = c<<8 ^ crcTable[byte(c>>24)^v]
+	}
+	return c
+}
+
+// checkCRC returns ErrCRC if the CRC_32 at the end of the section does not
+// match.
+func checkCRC(section []byte) error {
+	if len(section) < crc32size || mpegCRC32(section) != 0 {
+		return ErrCRC
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io/ioutil"
+	"sort"
+	"time"
 
 	"github.com/drillbits/go-arib/arib/xcs"
 	"github.com/drillbits/go-ts/ts"
@@ -253,17 +255,17 @@ func (d ServiceDescriptor) ProviderNameLength() int {
 	return int(d[3])
 }
 
-func (d ServiceDescriptor) ProviderName() (string, error) {
-	return decodeXCS(d[4 : 4+d.ProviderNameLength()])
+func (d ServiceDescriptor) ProviderName() String {
+	return newString(d[4:4+d.ProviderNameLength()], xcs.XCSEncoding)
 }
 
 func (d ServiceDescriptor) NameLength() int {
 	return int(d[4+d.ProviderNameLength()])
 }
 
-func (d ServiceDescriptor) Name() (string, error) {
+func (d ServiceDescriptor) Name() String {
 	pos := 4 + d.ProviderNameLength() + 1
-	return decodeXCS(d[pos : pos+d.NameLength()])
+	return newString(d[pos:pos+d.NameLength()], xcs.XCSEncoding)
 }
 
 // ShortEventDescriptor is the short_event_descriptor.
@@ -293,14 +295,8 @@ func (d ShortEventDescriptor) EventNameLength() int {
 }
 
 // EventName returns the name of the event.
-func (d ShortEventDescriptor) EventName() (string, error) {
-	r := bytes.NewReader(d[6 : 6+d.EventNameLength()])
-	tr := transform.NewReader(r, xcs.XCSEncoding.NewDecoder())
-	b, err := ioutil.ReadAll(tr)
-	if err != nil {
-		return "", err
-	}
-	return string(b), nil
+func (d ShortEventDescriptor) EventName() String {
+	return newString(d[6:6+d.EventNameLength()], xcs.XCSEncoding)
 }
 
 // TextLength returns the length of the text.
@@ -310,9 +306,177 @@ func (d ShortEventDescriptor) TextLength() int {
 }
 
 // Text returns the text of the short_event_descriptor.
-func (d ShortEventDescriptor) Text() (string, error) {
+func (d ShortEventDescriptor) Text() String {
 	n := 6 + d.EventNameLength() + 1
-	return decodeXCS(d[n : n+d.TextLength()])
+	return newString(d[n:n+d.TextLength()], xcs.XCSEncoding)
+}
+
+// ExtendedEventDescriptor is the extended_event_descriptor.
+// extended_event_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     descriptor_number            4 uimsbf [2]
+//     last_descriptor_number       4 uimsbf [2]
+//     ISO_639_language_code       24 bslbf  [3-5]
+//     length_of_items              8 uimsbf [6]
+//     for (i=0;i<N;i++){
+//         item_description_length  8 uimsbf
+//         for (j=0;j<N;j++){
+//             item_description_char 8 uimsbf
+//         }
+//         item_length              8 uimsbf
+//         for (j=0;j<N;j++){
+//             item_char            8 uimsbf
+//         }
+//     }
+//     text_length                  8 uimsbf
+//     for (i=0;i<N;i++){
+//         text_char                8 uimsbf
+//     }
+// }
+type ExtendedEventDescriptor ts.Descriptor
+
+// IsExtendedEventDescriptor reports whether the descriptor is the extended_event_descriptor.
+func IsExtendedEventDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0x4E
+}
+
+// ToExtendedEventDescriptor converts the descriptor to the extended_event_descriptor.
+func ToExtendedEventDescriptor(d ts.Descriptor) (ExtendedEventDescriptor, error) {
+	if !IsExtendedEventDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for extended_event_descriptor", d.Tag())
+	}
+	return ExtendedEventDescriptor(d), nil
+}
+
+// DescriptorNumber returns the descriptor_number.
+func (d ExtendedEventDescriptor) DescriptorNumber() int {
+	return int(d[2] & 0xF0 >> 4)
+}
+
+// LastDescriptorNumber returns the last_descriptor_number.
+func (d ExtendedEventDescriptor) LastDescriptorNumber() int {
+	return int(d[2] & 0x0F)
+}
+
+// ISO639LanguageCode returns the language code.
+func (d ExtendedEventDescriptor) ISO639LanguageCode() (string, error) {
+	return decodeISO8859_1(d[3:6])
+}
+
+// LengthOfItems returns the length of the items.
+func (d ExtendedEventDescriptor) LengthOfItems() int {
+	return int(d[6])
+}
+
+// Items returns the items of the descriptor. An item may be continued from
+// the last item of the previous descriptor, see ExtendedEventItems.
+func (d ExtendedEventDescriptor) Items() []ExtendedEventItem {
+	var items []ExtendedEventItem
+	end := 7 + d.LengthOfItems()
+	if end > len(d) {
+		end = len(d)
+	}
+	for pos := 7; pos < end; {
+		size := 1 + int(d[pos]) // item_description_length .. item_description_char
+		if pos+size >= end {
+			break
+		}
+		size += 1 + int(d[pos+size]) // item_length .. item_char
+		if pos+size > end {
+			break
+		}
+		items = append(items, ExtendedEventItem(d[pos:pos+size]))
+		pos += size
+	}
+	return items
+}
+
+// TextLength returns the length of the text.
+func (d ExtendedEventDescriptor) TextLength() int {
+	return int(d[7+d.LengthOfItems()])
+}
+
+// Text returns the text of the extended_event_descriptor.
+func (d ExtendedEventDescriptor) Text() String {
+	n := 7 + d.LengthOfItems() + 1
+	return newString(d[n:n+d.TextLength()], xcs.XCSEncoding)
+}
+
+// ExtendedEventItem is the item of the extended_event_descriptor.
+type ExtendedEventItem []byte
+
+// DescriptionLength returns the length of the item description.
+func (i ExtendedEventItem) DescriptionLength() int {
+	return int(i[0])
+}
+
+// Description returns the item description.
+func (i ExtendedEventItem) Description() String {
+	return newString(i[1:1+i.DescriptionLength()], xcs.XCSEncoding)
+}
+
+// ItemLength returns the length of the item.
+func (i ExtendedEventItem) ItemLength() int {
+	return int(i[1+i.DescriptionLength()])
+}
+
+// Item returns the item text.
+func (i ExtendedEventItem) Item() String {
+	n := 1 + i.DescriptionLength() + 1
+	return newString(i[n:n+i.ItemLength()], xcs.XCSEncoding)
+}
+
+// EventItem is an item of the event reassembled from the
+// extended_event_descriptors.
+type EventItem struct {
+	// Description is the item description, e.g. "出演者".
+	Description String
+
+	// Item is the item text.
+	Item String
+}
+
+// ExtendedEventItems returns the items of the extended_event_descriptors in
+// order of descriptor_number. The text of an item may be split across
+// descriptors, even in the middle of a character, so the raw bytes of the
+// first item of a descriptor whose description is empty are concatenated to
+// the last item of the previous descriptor before decoding. The other items
+// whose descriptions are empty are items of their own.
+func ExtendedEventItems(ds []ExtendedEventDescriptor) []EventItem {
+	ds = append([]ExtendedEventDescriptor(nil), ds...)
+	sort.SliceStable(ds, func(i, j int) bool {
+		return ds[i].DescriptorNumber() < ds[j].DescriptorNumber()
+	})
+
+	var descs, items [][]byte
+	prev := -1 // descriptor_number of the previous descriptor, if it has items
+	for _, d := range ds {
+		cont := prev >= 0 && d.DescriptorNumber() == prev+1
+		dItems := d.Items()
+		prev = -1
+		if len(dItems) > 0 {
+			prev = d.DescriptorNumber()
+		}
+		for j, item := range dItems {
+			if j == 0 && cont && item.DescriptionLength() == 0 {
+				n := len(items) - 1
+				items[n] = append(items[n], item.Item().Bytes()...)
+				continue
+			}
+			descs = append(descs, item.Description().Bytes())
+			items = append(items, append([]byte(nil), item.Item().Bytes()...))
+		}
+	}
+
+	eventItems := make([]EventItem, len(items))
+	for i := range items {
+		eventItems[i] = EventItem{
+			Description: newString(descs[i], xcs.XCSEncoding),
+			Item:        newString(items[i], xcs.XCSEncoding),
+		}
+	}
+	return eventItems
 }
 
 // ComponentDescriptor is the component_descriptor.
@@ -349,8 +513,8 @@ func (d ComponentDescriptor) ISO639LanguageCode() (string, error) {
 }
 
 // Text returns the text of the component_descriptor.
-func (d ComponentDescriptor) Text() (string, error) {
-	return decodeXCS(d[8:len(d)])
+func (d ComponentDescriptor) Text() String {
+	return newString(d[8:len(d)], xcs.XCSEncoding)
 }
 
 // ContentDescriptor is the content_descriptor.
@@ -405,6 +569,136 @@ func (n Nibble) UserNibble2() byte {
 
 // TODO: continue...
 
+// LocalTimeOffsetDescriptor is the local_time_offset_descriptor.
+// local_time_offset_descriptor(){
+//     descriptor_tag               8 uimsbf
+//     descriptor_length            8 uimsbf
+//     for (i=0;i<N;i++){
+//         country_code            24 bslbf
+//         country_region_id        6 bslbf
+//         reserved                 1 bslbf
+//         local_time_offset_polarity 1 bslbf
+//         local_time_offset       16 bslbf
+//         time_of_change          40 bslbf
+//         next_time_offset        16 bslbf
+//     }
+// }
+type LocalTimeOffsetDescriptor ts.Descriptor
+
+// IsLocalTimeOffsetDescriptor reports whether the descriptor is the local_time_offset_descriptor.
+func IsLocalTimeOffsetDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0x58
+}
+
+// ToLocalTimeOffsetDescriptor converts the descriptor to the local_time_offset_descriptor.
+func ToLocalTimeOffsetDescriptor(d ts.Descriptor) (LocalTimeOffsetDescriptor, error) {
+	if !IsLocalTimeOffsetDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for local_time_offset_descriptor", d.Tag())
+	}
+	return LocalTimeOffsetDescriptor(d), nil
+}
+
+// Offsets returns the local time offsets of the regions.
+func (d LocalTimeOffsetDescriptor) Offsets() []LocalTimeOffset {
+	var offsets []LocalTimeOffset
+	size := 13 // country_code .. next_time_offset
+	for pos := 2; pos+size <= len(d); pos += size {
+		offsets = append(offsets, LocalTimeOffset(d[pos:pos+size]))
+	}
+	return offsets
+}
+
+// LocalTimeOffset is the local time offset of a region.
+type LocalTimeOffset []byte
+
+// CountryCode returns the country_code, e.g. "JPN".
+func (o LocalTimeOffset) CountryCode() (string, error) {
+	return decodeISO8859_1(o[0:3])
+}
+
+// CountryRegionID returns the country_region_id.
+func (o LocalTimeOffset) CountryRegionID() int {
+	return int(o[3] & 0xFC >> 2)
+}
+
+// LocalTimeOffsetPolarity returns the local_time_offset_polarity. 0 means
+// the local time is ahead of JST, and 1 means it is behind.
+func (o LocalTimeOffset) LocalTimeOffsetPolarity() byte {
+	return o[3] & 0x01
+}
+
+// LocalTimeOffset returns the local_time_offset, which is negative if the
+// polarity is 1.
+func (o LocalTimeOffset) LocalTimeOffset() time.Duration {
+	d, _ := decodeOffset(o[4:6])
+	return o.signed(d)
+}
+
+// TimeOfChange returns the time_of_change, the time when the offset changes
+// to the next one, in loc, or JST if loc is nil.
+func (o LocalTimeOffset) TimeOfChange(loc *time.Location) time.Time {
+	t, _ := DecodeTime(o[6:11], loc)
+	return t
+}
+
+// NextTimeOffset returns the next_time_offset, which is negative if the
+// polarity is 1.
+func (o LocalTimeOffset) NextTimeOffset() time.Duration {
+	d, _ := decodeOffset(o[11:13])
+	return o.signed(d)
+}
+
+func (o LocalTimeOffset) signed(d time.Duration) time.Duration {
+	if o.LocalTimeOffsetPolarity() == 1 {
+		return -d
+	}
+	return d
+}
+
+// PartialTransportStreamDescriptor is the partial_transport_stream_descriptor.
+// partial_transport_stream_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     DVB_reserved_future_use      2 bslbf  [2]
+//     peak_rate                   22 uimsbf [2-4]
+//     DVB_reserved_future_use      2 bslbf  [5]
+//     minimum_overall_smoothing_rate 22 uimsbf [5-7]
+//     DVB_reserved_future_use      2 bslbf  [8]
+//     maximum_overall_smoothing_buffer 14 uimsbf [8-9]
+// }
+type PartialTransportStreamDescriptor ts.Descriptor
+
+// IsPartialTransportStreamDescriptor reports whether the descriptor is the partial_transport_stream_descriptor.
+func IsPartialTransportStreamDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0x63
+}
+
+// ToPartialTransportStreamDescriptor converts the descriptor to the partial_transport_stream_descriptor.
+func ToPartialTransportStreamDescriptor(d ts.Descriptor) (PartialTransportStreamDescriptor, error) {
+	if !IsPartialTransportStreamDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for partial_transport_stream_descriptor", d.Tag())
+	}
+	return PartialTransportStreamDescriptor(d), nil
+}
+
+// PeakRate returns the peak_rate, the maximum momentary transport packet
+// rate in units of 400 bit/s.
+func (d PartialTransportStreamDescriptor) PeakRate() int {
+	return int(d[2]&0x3F)<<16 | int(d[3])<<8 | int(d[4])
+}
+
+// MinimumOverallSmoothingRate returns the minimum_overall_smoothing_rate in
+// units of 400 bit/s, or 0x3FFFFF if it is undefined.
+func (d PartialTransportStreamDescriptor) MinimumOverallSmoothingRate() int {
+	return int(d[5]&0x3F)<<16 | int(d[6])<<8 | int(d[7])
+}
+
+// MaximumOverallSmoothingBuffer returns the maximum_overall_smoothing_buffer
+// in bytes, or 0x3FFF if it is undefined.
+func (d PartialTransportStreamDescriptor) MaximumOverallSmoothingBuffer() int {
+	return int(d[8]&0x3F)<<8 | int(d[9])
+}
+
 // EventGroupDescriptor is the event_group_descriptor.
 // descriptor_tag            8 [0]
 // descriptor_length         8 [1]
@@ -636,6 +930,149 @@ func (c DigitalCopyControlComponent) MaximumBitrate() int {
 	return int(c[2])
 }
 
+// NetworkIdentificationDescriptor is the network_identification_descriptor.
+// network_identification_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     country_code                24 bslbf  [2-4]
+//     media_type                  16 bslbf  [5-6]
+//     network_id                  16 uimsbf [7-8]
+//     for (i=0;i<N;i++){
+//         private_data             8 bslbf
+//     }
+// }
+type NetworkIdentificationDescriptor ts.Descriptor
+
+// IsNetworkIdentificationDescriptor reports whether the descriptor is the network_identification_descriptor.
+func IsNetworkIdentificationDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0xC2
+}
+
+// ToNetworkIdentificationDescriptor converts the descriptor to the network_identification_descriptor.
+func ToNetworkIdentificationDescriptor(d ts.Descriptor) (NetworkIdentificationDescriptor, error) {
+	if !IsNetworkIdentificationDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for network_identification_descriptor", d.Tag())
+	}
+	return NetworkIdentificationDescriptor(d), nil
+}
+
+// CountryCode returns the country_code, e.g. "JPN".
+func (d NetworkIdentificationDescriptor) CountryCode() (string, error) {
+	return decodeISO8859_1(d[2:5])
+}
+
+// MediaType returns the media_type, e.g. "BS", "CS" or "AB".
+func (d NetworkIdentificationDescriptor) MediaType() (string, error) {
+	return decodeISO8859_1(d[5:7])
+}
+
+// NetworkID returns the network_id.
+func (d NetworkIdentificationDescriptor) NetworkID() NetworkID {
+	return NetworkID(binary.BigEndian.Uint16(d[7:9]))
+}
+
+// PrivateData returns the private_data.
+func (d NetworkIdentificationDescriptor) PrivateData() []byte {
+	return d[9:]
+}
+
+// PartialTransportStreamTimeDescriptor is the partialTS_time_descriptor.
+// partialTS_time_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     event_version_number         8 uimsbf [2]
+//     event_start_time            40 bslbf  [3-7]
+//     duration                    24 bslbf  [8-10]
+//     offset                      24 bslbf  [11-13]
+//     reserved                     5 bslbf  [14]
+//     offset_flag                  1 bslbf  [14]
+//     other_descriptor_status      1 bslbf  [14]
+//     JST_time_flag                1 bslbf  [14]
+//     if (JST_time_flag == 1){
+//         JST_time                40 bslbf  [15-19]
+//     }
+// }
+type PartialTransportStreamTimeDescriptor ts.Descriptor
+
+// IsPartialTransportStreamTimeDescriptor reports whether the descriptor is the partialTS_time_descriptor.
+func IsPartialTransportStreamTimeDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0xC3
+}
+
+// ToPartialTransportStreamTimeDescriptor converts the descriptor to the partialTS_time_descriptor.
+func ToPartialTransportStreamTimeDescriptor(d ts.Descriptor) (PartialTransportStreamTimeDescriptor, error) {
+	if !IsPartialTransportStreamTimeDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for partialTS_time_descriptor", d.Tag())
+	}
+	return PartialTransportStreamTimeDescriptor(d), nil
+}
+
+// EventVersionNumber returns the event_version_number.
+func (d PartialTransportStreamTimeDescriptor) EventVersionNumber() byte {
+	return d[2]
+}
+
+// EventStartTime returns the event_start_time in loc, or JST if loc is nil.
+// It returns false if the event_start_time is undefined.
+func (d PartialTransportStreamTimeDescriptor) EventStartTime(loc *time.Location) (time.Time, bool) {
+	return DecodeTime(d[3:8], loc)
+}
+
+// Duration returns the duration of the event, or 0 if it is undefined.
+func (d PartialTransportStreamTimeDescriptor) Duration() time.Duration {
+	t, _ := DecodeDuration(d[8:11])
+	return t
+}
+
+// HasDuration reports whether the duration is defined.
+func (d PartialTransportStreamTimeDescriptor) HasDuration() bool {
+	_, ok := DecodeDuration(d[8:11])
+	return ok
+}
+
+// OffsetFlag returns the offset_flag. 0 means the offset is added to the
+// time, and 1 means it is subtracted.
+func (d PartialTransportStreamTimeDescriptor) OffsetFlag() byte {
+	return d[14] & 0x04 >> 2
+}
+
+// Offset returns the offset between the time of the recording and the time
+// in the stream, which is negative if the offset_flag is 1. It returns 0 if
+// the offset is undefined.
+func (d PartialTransportStreamTimeDescriptor) Offset() time.Duration {
+	t, _ := DecodeDuration(d[11:14])
+	if d.OffsetFlag() == 1 {
+		return -t
+	}
+	return t
+}
+
+// OtherDescriptorStatus returns the other_descriptor_status. 1 means the
+// other descriptors in the loop may differ from the ones of the event.
+func (d PartialTransportStreamTimeDescriptor) OtherDescriptorStatus() byte {
+	return d[14] & 0x02 >> 1
+}
+
+// JSTTimeFlag returns the JST_time_flag.
+func (d PartialTransportStreamTimeDescriptor) JSTTimeFlag() byte {
+	return d[14] & 0x01
+}
+
+// HasJSTTime reports whether the descriptor has the JST_time.
+func (d PartialTransportStreamTimeDescriptor) HasJSTTime() bool {
+	return d.JSTTimeFlag() == 1 && len(d) >= 20
+}
+
+// JSTTime returns the JST_time in loc, or JST if loc is nil. It returns the
+// zero time if the descriptor does not have it.
+func (d PartialTransportStreamTimeDescriptor) JSTTime(loc *time.Location) time.Time {
+	if !d.HasJSTTime() {
+		return time.Time{}
+	}
+	t, _ := DecodeTime(d[15:20], loc)
+	return t
+}
+
 // AudioComponentDescriptor is the audio_component_descriptor.
 type AudioComponentDescriptor ts.Descriptor
 
@@ -702,12 +1139,12 @@ func (d AudioComponentDescriptor) ISO639LanguageCode2() (string, error) {
 }
 
 // Text returns the text of the audio_component_descriptor.
-func (d AudioComponentDescriptor) Text() (string, error) {
+func (d AudioComponentDescriptor) Text() String {
 	n := 11
 	if d.ESMultiLingualFlag() {
 		n += 3 // size of ISO_639_language_code_2
 	}
-	return decodeXCS(d[n:len(d)])
+	return newString(d[n:len(d)], xcs.XCSEncoding)
 }
 
 // DataContentDescriptor is the data_content_descriptor.
@@ -774,9 +1211,366 @@ func (d DataContentDescriptor) TextLength() int {
 }
 
 // Text returns the text of the data_content_descriptor.
-func (d DataContentDescriptor) Text() (string, error) {
+func (d DataContentDescriptor) Text() String {
 	n := d.offsetComponentRefs() + d.NumOfComponentRef() + 3 + 1
-	return decodeXCS(d[n : n+d.TextLength()])
+	return newString(d[n:n+d.TextLength()], xcs.XCSEncoding)
+}
+
+// ExtendedBroadcasterDescriptor is the extended_broadcaster_descriptor.
+// extended_broadcaster_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     broadcaster_type             4 uimsbf [2]
+//     reserved_future_use          4 bslbf  [2]
+//     if (broadcaster_type == 0x1 || broadcaster_type == 0x2){
+//         terrestrial_broadcaster_id 16 uimsbf [3-4]
+//         number_of_affiliation_id_loop 4 uimsbf [5]
+//         number_of_broadcaster_id_loop 4 uimsbf [5]
+//         for (i=0;i<N1;i++){
+//             affiliation_id       8 uimsbf
+//         }
+//         for (j=0;j<N2;j++){
+//             original_network_id 16 uimsbf
+//             broadcaster_id       8 uimsbf
+//         }
+//         for (k=0;k<N3;k++){
+//             private_data_byte    8 bslbf
+//         }
+//     } else {
+//         for (i=0;i<N;i++){
+//             reserved_future_use  8 bslbf
+//         }
+//     }
+// }
+// For broadcaster_type 0x2, the IDs are the terrestrial_sound_broadcaster_id
+// and the sound_broadcast_affiliation_id.
+type ExtendedBroadcasterDescriptor ts.Descriptor
+
+// Broadcaster types
+const (
+	BroadcasterTypeTerrestrial      = 0x1
+	BroadcasterTypeTerrestrialSound = 0x2
+)
+
+// IsExtendedBroadcasterDescriptor reports whether the descriptor is the extended_broadcaster_descriptor.
+func IsExtendedBroadcasterDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0xCE
+}
+
+// ToExtendedBroadcasterDescriptor converts the descriptor to the extended_broadcaster_descriptor.
+func ToExtendedBroadcasterDescriptor(d ts.Descriptor) (ExtendedBroadcasterDescriptor, error) {
+	if !IsExtendedBroadcasterDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for extended_broadcaster_descriptor", d.Tag())
+	}
+	return ExtendedBroadcasterDescriptor(d), nil
+}
+
+// BroadcasterType returns the broadcaster_type.
+func (d ExtendedBroadcasterDescriptor) BroadcasterType() byte {
+	return d[2] & 0xF0 >> 4
+}
+
+// IsTerrestrial reports whether the broadcaster is a terrestrial broadcaster
+// or a terrestrial sound broadcaster, which has the IDs.
+func (d ExtendedBroadcasterDescriptor) IsTerrestrial() bool {
+	t := d.BroadcasterType()
+	return (t == BroadcasterTypeTerrestrial || t == BroadcasterTypeTerrestrialSound) && len(d) >= 6
+}
+
+// TerrestrialBroadcasterID returns the terrestrial_broadcaster_id, or the
+// terrestrial_sound_broadcaster_id.
+func (d ExtendedBroadcasterDescriptor) TerrestrialBroadcasterID() uint16 {
+	if !d.IsTerrestrial() {
+		return 0
+	}
+	return binary.BigEndian.Uint16(d[3:5])
+}
+
+// NumberOfAffiliationIDLoop returns the number_of_affiliation_id_loop.
+func (d ExtendedBroadcasterDescriptor) NumberOfAffiliationIDLoop() int {
+	if !d.IsTerrestrial() {
+		return 0
+	}
+	return int(d[5] & 0xF0 >> 4)
+}
+
+// NumberOfBroadcasterIDLoop returns the number_of_broadcaster_id_loop.
+func (d ExtendedBroadcasterDescriptor) NumberOfBroadcasterIDLoop() int {
+	if !d.IsTerrestrial() {
+		return 0
+	}
+	return int(d[5] & 0x0F)
+}
+
+// AffiliationIDs returns the affiliation_ids, or the
+// sound_broadcast_affiliation_ids.
+func (d ExtendedBroadcasterDescriptor) AffiliationIDs() []byte {
+	if !d.IsTerrestrial() {
+		return nil
+	}
+	end := 6 + d.NumberOfAffiliationIDLoop()
+	if end > len(d) {
+		end = len(d)
+	}
+	return d[6:end]
+}
+
+// Broadcasters returns the broadcasters related to the broadcaster, e.g.
+// the ones sharing the EPG.
+func (d ExtendedBroadcasterDescriptor) Broadcasters() []BroadcasterRef {
+	if !d.IsTerrestrial() {
+		return nil
+	}
+	var refs []BroadcasterRef
+	size := 3 // original_network_id .. broadcaster_id
+	pos := 6 + d.NumberOfAffiliationIDLoop()
+	for i := 0; i < d.NumberOfBroadcasterIDLoop() && pos+size <= len(d); i++ {
+		refs = append(refs, BroadcasterRef(d[pos:pos+size]))
+		pos += size
+	}
+	return refs
+}
+
+// PrivateDataBytes returns the private_data_bytes.
+func (d ExtendedBroadcasterDescriptor) PrivateDataBytes() []byte {
+	if !d.IsTerrestrial() {
+		return nil
+	}
+	pos := 6 + d.NumberOfAffiliationIDLoop() + 3*d.NumberOfBroadcasterIDLoop()
+	if pos > len(d) {
+		return nil
+	}
+	return d[pos:]
+}
+
+// BroadcasterRef is the broadcaster in the extended_broadcaster_descriptor.
+type BroadcasterRef []byte
+
+// OriginalNetworkID returns the original_network_id.
+func (r BroadcasterRef) OriginalNetworkID() OriginalNetworkID {
+	return OriginalNetworkID(binary.BigEndian.Uint16(r[0:2]))
+}
+
+// BroadcasterID returns the broadcaster_id.
+func (r BroadcasterRef) BroadcasterID() byte {
+	return r[2]
+}
+
+// LogoTransmissionDescriptor is the logo_transmission_descriptor.
+// logo_transmission_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     logo_transmission_type       8 uimsbf [2]
+//     if (logo_transmission_type == 0x01){
+//         reserved_future_use      7 bslbf  [3]
+//         logo_id                  9 uimsbf [3-4]
+//         reserved_future_use      4 bslbf  [5]
+//         logo_version            12 uimsbf [5-6]
+//         download_data_id        16 uimsbf [7-8]
+//     } else if (logo_transmission_type == 0x02){
+//         reserved_future_use      7 bslbf  [3]
+//         logo_id                  9 uimsbf [3-4]
+//     } else if (logo_transmission_type == 0x03){
+//         for (i=0;i<N;i++){
+//             logo_char            8 uimsbf
+//         }
+//     } else {
+//         for (j=0;j<M;j++){
+//             reserved_future_use  8 bslbf
+//         }
+//     }
+// }
+type LogoTransmissionDescriptor ts.Descriptor
+
+// Logo transmission types
+const (
+	LogoTransmissionCDT        = 0x01 // CDT transmission
+	LogoTransmissionLogoID     = 0x02 // logo_id of another service
+	LogoTransmissionSimpleLogo = 0x03 // simple logo string
+)
+
+// IsLogoTransmissionDescriptor reports whether the descriptor is the logo_transmission_descriptor.
+func IsLogoTransmissionDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0xCF
+}
+
+// ToLogoTransmissionDescriptor converts the descriptor to the logo_transmission_descriptor.
+func ToLogoTransmissionDescriptor(d ts.Descriptor) (LogoTransmissionDescriptor, error) {
+	if !IsLogoTransmissionDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for logo_transmission_descriptor", d.Tag())
+	}
+	return LogoTransmissionDescriptor(d), nil
+}
+
+// LogoTransmissionType returns the logo_transmission_type.
+func (d LogoTransmissionDescriptor) LogoTransmissionType() byte {
+	return d[2]
+}
+
+// HasLogoID reports whether the descriptor has the logo_id.
+func (d LogoTransmissionDescriptor) HasLogoID() bool {
+	switch d.LogoTransmissionType() {
+	case LogoTransmissionCDT:
+		return len(d) >= 9
+	case LogoTransmissionLogoID:
+		return len(d) >= 5
+	}
+	return false
+}
+
+// LogoID returns the logo_id, or 0 if the descriptor does not have it.
+func (d LogoTransmissionDescriptor) LogoID() uint16 {
+	if !d.HasLogoID() {
+		return 0
+	}
+	return uint16(d[3]&0x01)<<8 | uint16(d[4])
+}
+
+// LogoVersion returns the logo_version of the CDT transmission.
+func (d LogoTransmissionDescriptor) LogoVersion() uint16 {
+	if d.LogoTransmissionType() != LogoTransmissionCDT || !d.HasLogoID() {
+		return 0
+	}
+	return uint16(d[5]&0x0F)<<8 | uint16(d[6])
+}
+
+// DownloadDataID returns the download_data_id of the CDT transmission.
+func (d LogoTransmissionDescriptor) DownloadDataID() uint16 {
+	if d.LogoTransmissionType() != LogoTransmissionCDT || !d.HasLogoID() {
+		return 0
+	}
+	return binary.BigEndian.Uint16(d[7:9])
+}
+
+// SimpleLogo returns the simple logo string.
+func (d LogoTransmissionDescriptor) SimpleLogo() String {
+	if d.LogoTransmissionType() != LogoTransmissionSimpleLogo {
+		return newString(nil, xcs.XCSEncoding)
+	}
+	return newString(d[3:], xcs.XCSEncoding)
+}
+
+// SIParameterDescriptor is the SI_parameter_descriptor.
+// SI_parameter_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     parameter_version            8 uimsbf [2]
+//     update_time                 16 uimsbf [3-4]
+//     for (i=0;i<N;i++){
+//         table_id                 8 uimsbf
+//         table_description_length 8 uimsbf
+//         for (j=0;j<N;j++){
+//             table_description_byte 8 uimsbf
+//         }
+//     }
+// }
+type SIParameterDescriptor ts.Descriptor
+
+// IsSIParameterDescriptor reports whether the descriptor is the SI_parameter_descriptor.
+func IsSIParameterDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0xD7
+}
+
+// ToSIParameterDescriptor converts the descriptor to the SI_parameter_descriptor.
+func ToSIParameterDescriptor(d ts.Descriptor) (SIParameterDescriptor, error) {
+	if !IsSIParameterDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for SI_parameter_descriptor", d.Tag())
+	}
+	if len(d) < 5 || len(d) != 2+int(d[1]) {
+		return nil, fmt.Errorf("arib: SI_parameter_descriptor has invalid length %d", len(d))
+	}
+	if err := checkLoop(d[5:], "table loop of the SI_parameter_descriptor"); err != nil {
+		return nil, err
+	}
+	return SIParameterDescriptor(d), nil
+}
+
+// ParameterVersion returns the parameter_version.
+func (d SIParameterDescriptor) ParameterVersion() byte {
+	return d[2]
+}
+
+// UpdateTime returns the update_time, the date when the parameters are
+// updated, in loc, or JST if loc is nil.
+func (d SIParameterDescriptor) UpdateTime(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = JST
+	}
+	y, m, day := DecodeMJD(binary.BigEndian.Uint16(d[3:5]))
+	return time.Date(y, m, day, 0, 0, 0, 0, loc)
+}
+
+// Tables returns the parameters of the tables.
+func (d SIParameterDescriptor) Tables() []SIParameterTable {
+	var tables []SIParameterTable
+	for pos := 5; pos+2 <= len(d); {
+		size := 2 + int(d[pos+1]) // table_id .. table_description_byte
+		if pos+size > len(d) {
+			break
+		}
+		tables = append(tables, SIParameterTable(d[pos:pos+size]))
+		pos += size
+	}
+	return tables
+}
+
+// SIParameterTable is the parameters of a table in the
+// SI_parameter_descriptor.
+type SIParameterTable []byte
+
+// TableID returns the table_id.
+func (t SIParameterTable) TableID() ts.TableID {
+	return ts.TableID(t[0])
+}
+
+// TableDescriptionLength returns the table_description_length.
+func (t SIParameterTable) TableDescriptionLength() int {
+	return int(t[1])
+}
+
+// TableDescription returns the table_description_bytes, whose format
+// depends on the table and the network.
+func (t SIParameterTable) TableDescription() []byte {
+	return t[2 : 2+t.TableDescriptionLength()]
+}
+
+// TableCycle returns the first table_cycle of the table description, which
+// is 8 bits of BCD in seconds for the tables with a single cycle, e.g. NIT,
+// SDT and BIT. It returns false if the description is not BCD.
+func (t SIParameterTable) TableCycle() (time.Duration, bool) {
+	if t.TableDescriptionLength() < 1 {
+		return 0, false
+	}
+	s, ok := DecodeBCD(t[2])
+	return time.Duration(s) * time.Second, ok
+}
+
+// BroadcasterNameDescriptor is the broadcaster_name_descriptor.
+// broadcaster_name_descriptor(){
+//     descriptor_tag               8 uimsbf [0]
+//     descriptor_length            8 uimsbf [1]
+//     for (i=0;i<N;i++){
+//         char                     8 uimsbf
+//     }
+// }
+type BroadcasterNameDescriptor ts.Descriptor
+
+// IsBroadcasterNameDescriptor reports whether the descriptor is the broadcaster_name_descriptor.
+func IsBroadcasterNameDescriptor(d ts.Descriptor) bool {
+	return d.Tag() == 0xD8
+}
+
+// ToBroadcasterNameDescriptor converts the descriptor to the broadcaster_name_descriptor.
+func ToBroadcasterNameDescriptor(d ts.Descriptor) (BroadcasterNameDescriptor, error) {
+	if !IsBroadcasterNameDescriptor(d) {
+		return nil, fmt.Errorf("0x%02X is not a tag for broadcaster_name_descriptor", d.Tag())
+	}
+	return BroadcasterNameDescriptor(d), nil
+}
+
+// Name returns the name of the broadcaster.
+func (d BroadcasterNameDescriptor) Name() String {
+	return newString(d[2:], xcs.XCSEncoding)
 }
 
 func decode(b []byte, t transform.Transformer) (string, error) {
@@ -789,10 +1583,6 @@ func decode(b []byte, t transform.Transformer) (string, error) {
 	return string(b), nil
 }
 
-func decodeXCS(b []byte) (string, error) {
-	return decode(b, xcs.XCSEncoding.NewDecoder())
-}
-
 func decodeISO8859_1(b []byte) (string, error) {
 	return decode(b, charmap.ISO8859_1.NewDecoder())
 }
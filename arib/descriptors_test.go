@@ -0,0 +1,198 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"testing"
+
+	"github.com/drillbits/go-arib/arib/xcs"
+)
+
+func TestServiceDescriptorLatin(t *testing.T) {
+	// service_descriptor of "TV Cultura" by "Fundação"
+	d := ServiceDescriptor{0x48, 0x14, 0x01,
+		0x08, 0x46, 0x75, 0x6E, 0x64, 0x61, 0xE7, 0xE3, 0x6F,
+		0x0A, 0x54, 0x56, 0x20, 0x43, 0x75, 0x6C, 0x74, 0x75, 0x72, 0x61,
+	}
+	provider, err := d.ProviderName().WithEncoding(xcs.LatinEncoding).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := d.Name().WithEncoding(xcs.LatinEncoding).Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if provider != "Fundação" || name != "TV Cultura" {
+		t.Errorf("ProviderName(), Name() => %s, %s, want %s, %s", provider, name, "Fundação", "TV Cultura")
+	}
+}
+
+// extendedEvent returns the extended_event_descriptor of the items, which are
+// pairs of the description and the item, and the text.
+func extendedEvent(number, last byte, items [][2][]byte, text []byte) ExtendedEventDescriptor {
+	var loop []byte
+	for _, item := range items {
+		loop = append(loop, byte(len(item[0])))
+		loop = append(loop, item[0]...)
+		loop = append(loop, byte(len(item[1])))
+		loop = append(loop, item[1]...)
+	}
+	d := []byte{0x4E, 0, number<<4 | last, 0x6A, 0x70, 0x6E, byte(len(loop))}
+	d = append(d, loop...)
+	d = append(d, byte(len(text)))
+	d = append(d, text...)
+	d[1] = byte(len(d) - 2)
+	return ExtendedEventDescriptor(d)
+}
+
+func TestExtendedEventDescriptor(t *testing.T) {
+	d := extendedEvent(1, 2, [][2][]byte{
+		{{0x3D, 0x50, 0x31, 0x69, 0x3C, 0x54}, {0x3B, 0x33, 0x45, 0x44}}, // 出演者, 山田
+		{{}, {0xA2, 0xA4}}, // あい
+	}, []byte{0x48, 0x56, 0x41, 0x48}) // 番組
+
+	if got := d.DescriptorNumber(); got != 1 {
+		t.Errorf("DescriptorNumber() => %d, want 1", got)
+	}
+	if got := d.LastDescriptorNumber(); got != 2 {
+		t.Errorf("LastDescriptorNumber() => %d, want 2", got)
+	}
+	if got, err := d.ISO639LanguageCode(); err != nil || got != "jpn" {
+		t.Errorf("ISO639LanguageCode() => %s, %v, want jpn", got, err)
+	}
+	items := d.Items()
+	if len(items) != 2 {
+		t.Fatalf("Items() => %d items, want 2", len(items))
+	}
+	if items[0].DescriptionLength() != 6 || items[0].ItemLength() != 4 {
+		t.Errorf("Items()[0] => lengths %d, %d, want 6, 4", items[0].DescriptionLength(), items[0].ItemLength())
+	}
+	if items[1].DescriptionLength() != 0 || string(items[1].Item().Bytes()) != "\xA2\xA4" {
+		t.Errorf("Items()[1] => %d, 0x%X, want 0, 0xA2A4", items[1].DescriptionLength(), items[1].Item().Bytes())
+	}
+	if got := items[0].Description().String(); got != "出演者" {
+		t.Errorf("Items()[0].Description() => %s, want 出演者", got)
+	}
+	if got := d.Text().String(); got != "番組" {
+		t.Errorf("Text() => %s, want 番組", got)
+	}
+}
+
+func TestExtendedEventItems(t *testing.T) {
+	t.Parallel()
+
+	for i, tc := range []struct {
+		ds   []ExtendedEventDescriptor
+		want [][2]string
+	}{
+		{
+			nil,
+			nil,
+		},
+		{
+			[]ExtendedEventDescriptor{
+				extendedEvent(0, 0, [][2][]byte{
+					{{0x3D, 0x50, 0x31, 0x69, 0x3C, 0x54}, {0x3B, 0x33, 0x45, 0x44}},
+					{{0x48, 0x56, 0x41, 0x48}, {0xA2, 0xA4}},
+				}, nil),
+			},
+			[][2]string{{"出演者", "山田"}, {"番組", "あい"}},
+		},
+		{
+			// 山田太郎 is split in the middle of 太, and the descriptors are
+			// not in order
+			[]ExtendedEventDescriptor{
+				extendedEvent(2, 2, [][2][]byte{
+					{{0x48, 0x56, 0x41, 0x48}, {0xA2, 0xA4}},
+				}, nil),
+				extendedEvent(0, 2, [][2][]byte{
+					{{0x3D, 0x50, 0x31, 0x69, 0x3C, 0x54}, {0x3B, 0x33, 0x45, 0x44, 0x42}},
+				}, nil),
+				extendedEvent(1, 2, [][2][]byte{
+					{{}, {0x40, 0x4F, 0x3A}},
+				}, nil),
+			},
+			[][2]string{{"出演者", "山田太郎"}, {"番組", "あい"}},
+		},
+		{
+			// the first item has no description
+			[]ExtendedEventDescriptor{
+				extendedEvent(0, 0, [][2][]byte{
+					{{}, {0xA2, 0xA4}},
+				}, nil),
+			},
+			[][2]string{{"", "あい"}},
+		},
+		{
+			// the second item has no description in the same descriptor
+			[]ExtendedEventDescriptor{
+				extendedEvent(0, 0, [][2][]byte{
+					{{0x3D, 0x50, 0x31, 0x69, 0x3C, 0x54}, {0x3B, 0x33, 0x45, 0x44}},
+					{{}, {0xA2, 0xA4}},
+				}, nil),
+			},
+			[][2]string{{"出演者", "山田"}, {"", "あい"}},
+		},
+		{
+			// the previous descriptor has no items
+			[]ExtendedEventDescriptor{
+				extendedEvent(0, 2, [][2][]byte{
+					{{0x3D, 0x50, 0x31, 0x69, 0x3C, 0x54}, {0x3B, 0x33, 0x45, 0x44}},
+				}, nil),
+				extendedEvent(1, 2, nil, []byte{0x48, 0x56, 0x41, 0x48}),
+				extendedEvent(2, 2, [][2][]byte{
+					{{}, {0xA2, 0xA4}},
+				}, nil),
+			},
+			[][2]string{{"出演者", "山田"}, {"", "あい"}},
+		},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			items := ExtendedEventItems(tc.ds)
+			if len(items) != len(tc.want) {
+				t.Fatalf("%d: ExtendedEventItems(...) => %d items, want %d", i, len(items), len(tc.want))
+			}
+			for j, item := range items {
+				desc, err := item.Description.Decode()
+				if err != nil {
+					t.Fatalf("%d: ExtendedEventItems(...)[%d].Description => %v", i, j, err)
+				}
+				text, err := item.Item.Decode()
+				if err != nil {
+					t.Fatalf("%d: ExtendedEventItems(...)[%d].Item => %v", i, j, err)
+				}
+				if desc != tc.want[j][0] || text != tc.want[j][1] {
+					t.Errorf("%d: ExtendedEventItems(...)[%d] => %s, %s, want %s, %s", i, j, desc, text, tc.want[j][0], tc.want[j][1])
+				}
+			}
+		})
+	}
+}
+
+func TestEventExtendedEventItems(t *testing.T) {
+	var descs []byte
+	descs = append(descs, extendedEvent(1, 1, [][2][]byte{{{}, {0x4F, 0x3A}}}, nil)...)
+	descs = append(descs, ShortEventDescriptor{0x4D, 0x05, 0x6A, 0x70, 0x6E, 0x00, 0x00}...)
+	descs = append(descs, extendedEvent(0, 1, [][2][]byte{{{0x3D, 0x50, 0x31, 0x69, 0x3C, 0x54}, {0x42, 0x40}}}, nil)...)
+	e := Event(append([]byte{0x00, 0x01, 0xE4, 0xA7, 0x21, 0x00, 0x00, 0x00, 0x30, 0x00, 0x80 | byte(len(descs)>>8), byte(len(descs))}, descs...))
+
+	items := e.ExtendedEventItems()
+	if len(items) != 1 || items[0].Description.String() != "出演者" || items[0].Item.String() != "太郎" {
+		t.Errorf("ExtendedEventItems() => %v, want [{出演者 太郎}]", items)
+	}
+}
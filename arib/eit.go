@@ -16,8 +16,6 @@ package arib
 
 import (
 	"encoding/binary"
-	"fmt"
-	"math"
 	"time"
 
 	"github.com/drillbits/go-ts/ts"
@@ -103,16 +101,29 @@ func (e Event) ID() EventID {
 	return EventID(binary.BigEndian.Uint16(e[:2]))
 }
 
-// StartTime returns the start_time.
+// StartTime returns the start_time in JST, or the zero time if it is
+// undefined, e.g. for an event whose time is not fixed yet.
 func (e Event) StartTime() time.Time {
-	y, m, d := decodeMJD(e[2], e[3])
-	t := time.Date(y+1900, time.Month(m), d, 0, 0, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60))
-	return t.Add(bcd(e[4], e[5], e[6]))
+	t, _ := e.StartTimeIn(JST)
+	return t
 }
 
-// Duration returns the duration.
+// StartTimeIn returns the start_time in loc, or JST if loc is nil. It returns
+// false if the start_time is undefined.
+func (e Event) StartTimeIn(loc *time.Location) (time.Time, bool) {
+	return DecodeTime(e[2:7], loc)
+}
+
+// Duration returns the duration, or 0 if it is undefined.
 func (e Event) Duration() time.Duration {
-	return bcd(e[7], e[8], e[9])
+	d, _ := DecodeDuration(e[7:10])
+	return d
+}
+
+// HasDuration reports whether the duration is defined.
+func (e Event) HasDuration() bool {
+	_, ok := DecodeDuration(e[7:10])
+	return ok
 }
 
 // RunningStatus returns the running_status.
@@ -140,25 +151,14 @@ func (e Event) Descriptors() []ts.Descriptor {
 	return ts.Descriptors(e[12:]) // event_id .. descriptors_loop_length
 }
 
-func decodeMJD(b1, b2 byte) (int, int, int) {
-	mjd := float64(uint16(b1&0xFF)<<8 | uint16(b2&0xFF))
-	y := math.Trunc((mjd - 15078.2) / 365.25)
-	m := math.Trunc((mjd - 14956.1 - math.Trunc(y*365.25)) / 30.6001)
-	d := mjd - 14956 - math.Trunc(y*365.25) - math.Trunc(m*30.6001)
-	var k float64
-	if m == 14 || m == 15 {
-		k = 1
+// ExtendedEventItems returns the items of the event reassembled from its
+// extended_event_descriptors.
+func (e Event) ExtendedEventItems() []EventItem {
+	var ds []ExtendedEventDescriptor
+	for _, d := range e.Descriptors() {
+		if IsExtendedEventDescriptor(d) {
+			ds = append(ds, ExtendedEventDescriptor(d))
+		}
 	}
-	y += k
-	m = m - 1 - k*12
-	return int(y), int(m), int(d)
-}
-
-func bcd(b1, b2, b3 byte) time.Duration {
-	hms := fmt.Sprintf("%02Xh%02Xm%02Xs", b1, b2, b3)
-	d, err := time.ParseDuration(hms)
-	if err != nil {
-		return time.Duration(-1)
-	}
-	return d
+	return ExtendedEventItems(ds)
 }
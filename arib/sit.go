@@ -0,0 +1,242 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/drillbits/go-ts/ts"
+)
+
+// TableIDSIT is the table_id of SIT.
+const TableIDSIT ts.TableID = 0x7F
+
+// SIT is a Selection Information Table, which replaces NIT and SDT in a
+// partial TS, e.g. a recorded file.
+// selection_information_section(){
+//     table_id                     8 uimsbf [0]
+//     section_syntax_indicator     1 bslbf  [1]
+//     DVB_reserved_future_use      1 bslbf  [1]
+//     ISO_reserved                 2 bslbf  [1]
+//     section_length              12 uimsbf [1-2]
+//     DVB_reserved_future_use     16 bslbf  [3-4]
+//     ISO_reserved                 2 bslbf  [5]
+//     version_number               5 uimsbf [5]
+//     current_next_indicator       1 bslbf  [5]
+//     section_number               8 uimsbf [6]
+//     last_section_number          8 uimsbf [7]
+//     DVB_reserved_for_future_use  4 bslbf  [8]
+//     transmission_info_loop_length 12 uimsbf [8-9]
+//     for (i=0;i<N;i++){
+//         descriptor()
+//     }
+//     for (j=0;j<N;j++){
+//         service_id              16 uimsbf
+//         DVB_reserved_future_use  1 bslbf
+//         running_status           3 bslbf
+//         service_loop_length     12 uimsbf
+//         for (k=0;k<N;k++){
+//             descriptor()
+//         }
+//     }
+//     CRC_32                      32 rpchof
+// }
+type SIT ts.PSI
+
+// ToSIT converts the section to the SIT. It returns ErrCRC if the CRC_32
+// does not match.
+func ToSIT(b []byte) (SIT, error) {
+	b, err := section(b, TableIDSIT, "SIT", 10+crc32size)
+	if err != nil {
+		return nil, err
+	}
+	t := SIT(b)
+	if 10+t.TransmissionInfoLoopLength()+crc32size > len(b) {
+		return nil, fmt.Errorf("arib: transmission_info_loop_length %d overflows the SIT", t.TransmissionInfoLoopLength())
+	}
+	if err := checkCRC(b); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// VersionNumber returns the version_number.
+func (t SIT) VersionNumber() int {
+	return ts.VersionNumber(t)
+}
+
+// CurrentNextIndicator returns the current_next_indicator.
+func (t SIT) CurrentNextIndicator() byte {
+	return ts.CurrentNextIndicator(t)
+}
+
+// SectionNumber returns the section_number.
+func (t SIT) SectionNumber() byte {
+	return ts.SectionNumber(t)
+}
+
+// LastSectionNumber returns the last_section_number.
+func (t SIT) LastSectionNumber() byte {
+	return ts.LastSectionNumber(t)
+}
+
+// TransmissionInfoLoopLength returns the transmission_info_loop_length.
+func (t SIT) TransmissionInfoLoopLength() int {
+	return int(uint16(t[9]&0xFF) | uint16(t[8]&0x0F)<<8)
+}
+
+// Descriptors returns the descriptors of the transmission information, e.g.
+// network_identification_descriptor and partial_transport_stream_descriptor.
+func (t SIT) Descriptors() []ts.Descriptor {
+	return ts.Descriptors(t[10 : 10+t.TransmissionInfoLoopLength()])
+}
+
+// Services returns the list of SITService.
+func (t SIT) Services() []SITService {
+	headsize := 4 // service_id .. service_loop_length
+	var services []SITService
+	pos := 10 + t.TransmissionInfoLoopLength()
+	for pos+headsize <= len(t)-crc32size {
+		size := headsize + SITService(t[pos:]).ServiceLoopLength()
+		if pos+size > len(t)-crc32size {
+			break
+		}
+		s := SITService(t[pos : pos+size])
+		pos += len(s)
+		services = append(services, s)
+	}
+	return services
+}
+
+// SITService is an information for the service in the SIT.
+type SITService []byte
+
+// ID returns the service_id.
+func (s SITService) ID() ServiceID {
+	return ServiceID(binary.BigEndian.Uint16(s[:2]))
+}
+
+// RunningStatus returns the running_status.
+func (s SITService) RunningStatus() RunningStatus {
+	return RunningStatus(s[2] & 0x70 >> 4)
+}
+
+// ServiceLoopLength returns the service_loop_length.
+func (s SITService) ServiceLoopLength() int {
+	return int(uint16(s[3]&0xFF) | uint16(s[2]&0x0F)<<8)
+}
+
+// Descriptors returns the descriptors, e.g. service_descriptor,
+// partialTS_time_descriptor and short_event_descriptor.
+func (s SITService) Descriptors() []ts.Descriptor {
+	return ts.Descriptors(s[4:]) // service_id .. service_loop_length
+}
+
+// ServiceIdentity is the identity of a service reconstructed from the SIT,
+// for a partial TS without SDT and EIT.
+type ServiceIdentity struct {
+	// NetworkID is the network_id of the network_identification_descriptor,
+	// or 0 if the SIT does not have it.
+	NetworkID NetworkID
+
+	ServiceID     ServiceID
+	RunningStatus RunningStatus
+
+	// ServiceType, ProviderName and Name are of the service_descriptor.
+	ServiceType  byte
+	ProviderName String
+	Name         String
+
+	// Event is the event of the service, or nil if the SIT has no
+	// descriptor of the event.
+	Event *EventIdentity
+}
+
+// EventIdentity is the identity of an event reconstructed from the SIT.
+type EventIdentity struct {
+	// VersionNumber, StartTime and Duration are of the
+	// partialTS_time_descriptor. StartTime is the zero time and Duration is
+	// 0 if they are undefined.
+	VersionNumber byte
+	StartTime     time.Time
+	Duration      time.Duration
+
+	// Name and Text are of the short_event_descriptor.
+	Name String
+	Text String
+
+	// Items are of the extended_event_descriptors.
+	Items []EventItem
+}
+
+// ServiceIdentities returns the identities of the services in the SIT. A
+// partialTS_time_descriptor in the transmission information applies to the
+// services without their own. The start times are in loc, or JST if loc is
+// nil.
+func (t SIT) ServiceIdentities(loc *time.Location) []ServiceIdentity {
+	var networkID NetworkID
+	var tsTime PartialTransportStreamTimeDescriptor
+	for _, d := range t.Descriptors() {
+		switch {
+		case IsNetworkIdentificationDescriptor(d) && len(d) >= 9:
+			networkID = NetworkIdentificationDescriptor(d).NetworkID()
+		case IsPartialTransportStreamTimeDescriptor(d) && len(d) >= 15:
+			tsTime = PartialTransportStreamTimeDescriptor(d)
+		}
+	}
+
+	var ids []ServiceIdentity
+	for _, s := range t.Services() {
+		id := ServiceIdentity{
+			NetworkID:     networkID,
+			ServiceID:     s.ID(),
+			RunningStatus: s.RunningStatus(),
+		}
+		timeDesc := tsTime
+		var event *EventIdentity
+		var exts []ExtendedEventDescriptor
+		for _, d := range s.Descriptors() {
+			switch {
+			case IsServiceDescriptor(d):
+				sd := ServiceDescriptor(d)
+				id.ServiceType = sd.Type()
+				id.ProviderName, id.Name = sd.ProviderName(), sd.Name()
+			case IsPartialTransportStreamTimeDescriptor(d) && len(d) >= 15:
+				timeDesc = PartialTransportStreamTimeDescriptor(d)
+			case IsShortEventDescriptor(d):
+				sed := ShortEventDescriptor(d)
+				event = &EventIdentity{Name: sed.EventName(), Text: sed.Text()}
+			case IsExtendedEventDescriptor(d):
+				exts = append(exts, ExtendedEventDescriptor(d))
+			}
+		}
+		if event == nil && (timeDesc != nil || len(exts) > 0) {
+			event = &EventIdentity{}
+		}
+		if timeDesc != nil {
+			event.VersionNumber = timeDesc.EventVersionNumber()
+			event.StartTime, _ = timeDesc.EventStartTime(loc)
+			event.Duration = timeDesc.Duration()
+		}
+		if event != nil {
+			event.Items = ExtendedEventItems(exts)
+		}
+		id.Event = event
+		ids = append(ids, id)
+	}
+	return ids
+}
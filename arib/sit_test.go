@@ -0,0 +1,120 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"testing"
+	"time"
+)
+
+// sit returns the SIT of the transmission information and the services.
+func sit(info []byte, services ...[]byte) []byte {
+	b := []byte{0x7F, 0xF0, 0x00, 0xFF, 0xFF, 0xC1, 0x00, 0x00, 0xF0 | byte(len(info)>>8), byte(len(info))}
+	b = append(b, info...)
+	for _, s := range services {
+		b = append(b, s...)
+	}
+	n := len(b) - 3 + 4
+	b[1], b[2] = 0xF0|byte(n>>8), byte(n)
+	return withCRC(b)
+}
+
+// sitService returns the service of the SIT with the descriptors.
+func sitService(id uint16, descs ...[]byte) []byte {
+	var loop []byte
+	for _, d := range descs {
+		loop = append(loop, d...)
+	}
+	b := []byte{byte(id >> 8), byte(id), 0xC0 | byte(len(loop)>>8), byte(len(loop))}
+	return append(b, loop...)
+}
+
+func TestSIT(t *testing.T) {
+	ni := []byte{0xC2, 0x09, 0x4A, 0x50, 0x4E, 0x42, 0x53, 0x00, 0x04, 0x01, 0x02}
+	pts := []byte{0x63, 0x08, 0xC0, 0x4E, 0x20, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	// version 3, 2017-01-01 21:00:00, 00:30:00, offset -00:00:10, JST_time 2017-01-01 21:05:00
+	ptst := []byte{0xC3, 0x12, 0x03, 0xE1, 0x9A, 0x21, 0x00, 0x00, 0x00, 0x30, 0x00, 0x00, 0x00, 0x10, 0xFD, 0xE1, 0x9A, 0x21, 0x05, 0x00}
+	service := []byte{0x48, 0x07, 0x01, 0x02, 0xA2, 0xA4, 0x02, 0xA6, 0xA8}
+	shortEvent := []byte{0x4D, 0x09, 0x6A, 0x70, 0x6E, 0x02, 0xA2, 0xA4, 0x02, 0xA6, 0xA8}
+	extendedEvent := []byte(extendedEvent(0, 0, [][2][]byte{{{0xA2}, {0xA4}}}, nil))
+
+	b := sit(append(append(append([]byte(nil), ni...), pts...), ptst...),
+		sitService(0x0101, service, shortEvent, extendedEvent),
+		sitService(0x0102),
+	)
+	st, err := ToSIT(b)
+	if err != nil {
+		t.Fatalf("ToSIT(...) => %v", err)
+	}
+	if st.VersionNumber() != 0 || st.CurrentNextIndicator() != 1 || len(st.Descriptors()) != 3 {
+		t.Errorf("VersionNumber(), CurrentNextIndicator(), Descriptors() => %d, %d, %X, want 0, 1, 3 descriptors",
+			st.VersionNumber(), st.CurrentNextIndicator(), st.Descriptors())
+	}
+
+	d := PartialTransportStreamDescriptor(st.Descriptors()[1])
+	if d.PeakRate() != 0x004E20 || d.MinimumOverallSmoothingRate() != 0x3FFFFF || d.MaximumOverallSmoothingBuffer() != 0x3FFF {
+		t.Errorf("PeakRate(), MinimumOverallSmoothingRate(), MaximumOverallSmoothingBuffer() => 0x%X, 0x%X, 0x%X, want 0x4E20, 0x3FFFFF, 0x3FFF",
+			d.PeakRate(), d.MinimumOverallSmoothingRate(), d.MaximumOverallSmoothingBuffer())
+	}
+
+	td := PartialTransportStreamTimeDescriptor(st.Descriptors()[2])
+	if td.Offset() != -10*time.Second || td.OtherDescriptorStatus() != 0 || !td.HasJSTTime() {
+		t.Errorf("Offset(), OtherDescriptorStatus(), HasJSTTime() => %v, %d, %v, want -10s, 0, true", td.Offset(), td.OtherDescriptorStatus(), td.HasJSTTime())
+	}
+	if want := time.Date(2017, 1, 1, 21, 5, 0, 0, JST); !td.JSTTime(JST).Equal(want) {
+		t.Errorf("JSTTime(JST) => %v, want %v", td.JSTTime(JST), want)
+	}
+
+	ids := st.ServiceIdentities(JST)
+	if len(ids) != 2 {
+		t.Fatalf("ServiceIdentities() => %d identities, want 2", len(ids))
+	}
+	id := ids[0]
+	if id.NetworkID != 0x0004 || id.ServiceID != 0x0101 || id.ServiceType != 0x01 {
+		t.Errorf("NetworkID, ServiceID, ServiceType => 0x%04X, 0x%04X, 0x%02X, want 0x0004, 0x0101, 0x01", id.NetworkID, id.ServiceID, id.ServiceType)
+	}
+	if id.ProviderName.String() != "あい" || id.Name.String() != "うえ" {
+		t.Errorf("ProviderName, Name => %s, %s, want あい, うえ", id.ProviderName, id.Name)
+	}
+	if id.Event == nil {
+		t.Fatalf("Event => nil, want the event")
+	}
+	start := time.Date(2017, 1, 1, 21, 0, 0, 0, JST)
+	if e := id.Event; e.VersionNumber != 3 || !e.StartTime.Equal(start) || e.Duration != 30*time.Minute {
+		t.Errorf("Event => %d, %v, %v, want 3, %v, 30m", e.VersionNumber, e.StartTime, e.Duration, start)
+	}
+	if e := id.Event; e.Name.String() != "あい" || e.Text.String() != "うえ" || len(e.Items) != 1 || e.Items[0].Item.String() != "い" {
+		t.Errorf("Event => %s, %s, %v, want あい, うえ, [{あ い}]", e.Name, e.Text, e.Items)
+	}
+
+	// the partialTS_time_descriptor of the transmission information
+	if e := ids[1].Event; ids[1].ServiceID != 0x0102 || e == nil || !e.StartTime.Equal(start) || e.Name.Bytes() != nil {
+		t.Errorf("ServiceIdentities()[1] => %+v, want the event at %v", ids[1], start)
+	}
+
+	b[len(b)-1] ^= 0x01
+	if _, err := ToSIT(b); err != ErrCRC {
+		t.Errorf("ToSIT(...) => %v, want %v", err, ErrCRC)
+	}
+}
+
+func TestNetworkIdentificationDescriptor(t *testing.T) {
+	d := NetworkIdentificationDescriptor{0xC2, 0x07, 0x4A, 0x50, 0x4E, 0x43, 0x53, 0x00, 0x07}
+	country, err1 := d.CountryCode()
+	media, err2 := d.MediaType()
+	if err1 != nil || err2 != nil || country != "JPN" || media != "CS" || d.NetworkID() != 0x0007 {
+		t.Errorf("CountryCode(), MediaType(), NetworkID() => %s, %s, 0x%04X, want JPN, CS, 0x0007", country, media, d.NetworkID())
+	}
+}
@@ -0,0 +1,122 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/drillbits/go-arib/arib/xcs"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// String is a text in SI. It keeps the raw bytes, and decodes them whenever
+// the text is needed, so keep the decoded text to decode it only once.
+type String struct {
+	raw []byte
+	enc encoding.Encoding
+}
+
+// NewString returns the String of a copy of the raw bytes in the encoding. The
+// encoding is xcs.XCSEncoding if e is nil.
+func NewString(raw []byte, e encoding.Encoding) String {
+	return newString(append([]byte(nil), raw...), e)
+}
+
+// newString returns the String which shares the raw bytes, e.g. of a
+// descriptor.
+func newString(raw []byte, e encoding.Encoding) String {
+	if e == nil {
+		e = xcs.XCSEncoding
+	}
+	return String{raw: raw, enc: e}
+}
+
+// Bytes returns the raw bytes.
+func (s String) Bytes() []byte {
+	return s.raw
+}
+
+// Encoding returns the encoding of the raw bytes.
+func (s String) Encoding() encoding.Encoding {
+	if s.enc == nil {
+		return xcs.XCSEncoding
+	}
+	return s.enc
+}
+
+// WithEncoding returns the String of the same raw bytes in the encoding.
+func (s String) WithEncoding(e encoding.Encoding) String {
+	return newString(s.raw, e)
+}
+
+// Decode returns the decoded text.
+func (s String) Decode() (string, error) {
+	return decodeString(s.raw, s.Encoding())
+}
+
+// String returns the decoded text, which is cut at the code which is not
+// decodable.
+func (s String) String() string {
+	t, _ := s.Decode()
+	return t
+}
+
+// MarshalText returns the decoded text. The codes which are not decodable
+// are replaced if the encoding supports lenient decoding, e.g.
+// xcs.XCSEncoding, or the text is cut at them.
+func (s String) MarshalText() ([]byte, error) {
+	return []byte(s.lenient()), nil
+}
+
+// MarshalJSON returns the decoded text as a JSON string like MarshalText.
+func (s String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.lenient())
+}
+
+// lenientEncoding is an encoding which decodes invalid codes leniently.
+type lenientEncoding interface {
+	NewLenientDecoder() *encoding.Decoder
+}
+
+// lenient returns the decoded text, which is decoded leniently again if the
+// raw bytes are not decodable.
+func (s String) lenient() string {
+	t, err := s.Decode()
+	if err == nil {
+		return t
+	}
+	if e, ok := s.Encoding().(lenientEncoding); ok {
+		if lt, err := readString(s.raw, e.NewLenientDecoder()); err == nil {
+			return lt
+		}
+	}
+	return t
+}
+
+// decodeString decodes b, and returns the text decoded until the error.
+func decodeString(b []byte, e encoding.Encoding) (string, error) {
+	return readString(b, e.NewDecoder())
+}
+
+// readString decodes b by the decoder, and returns the text decoded until the
+// error.
+func readString(b []byte, dec *encoding.Decoder) (string, error) {
+	tr := transform.NewReader(bytes.NewReader(b), dec)
+	t, err := ioutil.ReadAll(tr)
+	return string(t), err
+}
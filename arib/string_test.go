@@ -0,0 +1,81 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/drillbits/go-arib/arib/xcs"
+)
+
+func TestString(t *testing.T) {
+	for i, tc := range []struct {
+		s       String
+		text    string
+		err     bool
+		lenient string
+	}{
+		{NewString([]byte{0xA2, 0xA4}, nil), "あい", false, "あい"},
+		{NewString([]byte{0xA2, 0xFF, 0xA4}, xcs.XCSEncoding), "あ", true, "あ�い"},
+		{NewString([]byte{0x53, 0xE3, 0x6F}, xcs.LatinEncoding), "São", false, "São"},
+		{NewString([]byte{0x53, 0xE3, 0x6F}, nil).WithEncoding(xcs.LatinEncoding), "São", false, "São"},
+		{NewString([]byte{0x53, 0xFF, 0x6F}, xcs.LatinEncoding), "S", true, "S�o"},
+		{String{}, "", false, ""},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			text, err := tc.s.Decode()
+			if text != tc.text || (err != nil) != tc.err {
+				t.Errorf("%d: Decode() => %s, %v, want %s, error %t", i, text, err, tc.text, tc.err)
+			}
+			if got := fmt.Sprint(tc.s); got != tc.text {
+				t.Errorf("%d: String() => %s, want %s", i, got, tc.text)
+			}
+
+			b, err := json.Marshal(tc.s)
+			if want, _ := json.Marshal(tc.lenient); err != nil || !bytes.Equal(b, want) {
+				t.Errorf("%d: json.Marshal() => %s, %v, want %s", i, b, err, want)
+			}
+			if b, err := tc.s.MarshalText(); err != nil || string(b) != tc.lenient {
+				t.Errorf("%d: MarshalText() => %s, %v, want %s", i, b, err, tc.lenient)
+			}
+		})
+	}
+}
+
+func TestNewStringCopy(t *testing.T) {
+	raw := []byte{0xA2, 0xA4}
+	s := NewString(raw, nil)
+	raw[0] = 0xA6
+	if !bytes.Equal(s.Bytes(), []byte{0xA2, 0xA4}) || s.String() != "あい" {
+		t.Errorf("NewString(0xA2A4).Bytes(), String() => 0x%X, %s, want 0xA2A4, あい", s.Bytes(), s)
+	}
+}
+
+func TestStringBytes(t *testing.T) {
+	d := ShortEventDescriptor{0x4D, 0x09, 0x6A, 0x70, 0x6E, 0x02, 0xA2, 0xA4, 0x02, 0xA6, 0xA8}
+	name, text := d.EventName(), d.Text()
+	if !bytes.Equal(name.Bytes(), []byte{0xA2, 0xA4}) || !bytes.Equal(text.Bytes(), []byte{0xA6, 0xA8}) {
+		t.Errorf("EventName().Bytes(), Text().Bytes() => 0x%X, 0x%X, want 0xA2A4, 0xA6A8", name.Bytes(), text.Bytes())
+	}
+	if name.String() != "あい" || text.String() != "うえ" {
+		t.Errorf("EventName(), Text() => %s, %s, want あい, うえ", name, text)
+	}
+}
@@ -0,0 +1,157 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"fmt"
+	"time"
+)
+
+// JST is the time zone of the times in SI of ARIB. The times in SI of ISDB-Tb
+// are in the time zone of the network, e.g. time.FixedZone("BRT", -3*60*60)
+// in Brazil.
+var JST = time.FixedZone("JST", 9*60*60)
+
+// mjdUnixEpoch is the MJD of 1970-01-01.
+const mjdUnixEpoch = 40587
+
+// DecodeMJD returns the date of the Modified Julian Date.
+func DecodeMJD(mjd uint16) (year int, month time.Month, day int) {
+	return time.Unix((int64(mjd)-mjdUnixEpoch)*24*60*60, 0).UTC().Date()
+}
+
+// EncodeMJD returns the Modified Julian Date of the date. The date must be
+// in the range from 1858-11-17 to 2038-04-22.
+func EncodeMJD(year int, month time.Month, day int) uint16 {
+	days := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+	return uint16(days + mjdUnixEpoch)
+}
+
+// DecodeBCD returns the value of the 2 digits of BCD, or false if b is not
+// BCD.
+func DecodeBCD(b byte) (int, bool) {
+	hi, lo := b>>4, b&0x0F
+	if hi > 9 || lo > 9 {
+		return 0, false
+	}
+	return int(hi)*10 + int(lo), true
+}
+
+// EncodeBCD returns the 2 digits of BCD of n. It returns an error if n is
+// not in the range from 0 to 99.
+func EncodeBCD(n int) (byte, error) {
+	if n < 0 || n > 99 {
+		return 0, fmt.Errorf("arib: %d is out of range of 2 digits of BCD", n)
+	}
+	return byte(n/10<<4 | n%10), nil
+}
+
+// DecodeTime decodes the 40 bits of MJD and BCD of hours, minutes and
+// seconds, e.g. start_time, to the time in loc, or JST if loc is nil. It
+// returns false if the time is undefined, i.e. all bits are 1, not BCD, or
+// out of range of the clock.
+func DecodeTime(b []byte, loc *time.Location) (time.Time, bool) {
+	if isUndefined(b[:5]) {
+		return time.Time{}, false
+	}
+	h, min, sec, ok := decodeClock(b[2:5])
+	if !ok || h > 23 {
+		return time.Time{}, false
+	}
+	if loc == nil {
+		loc = JST
+	}
+	y, m, day := DecodeMJD(uint16(b[0])<<8 | uint16(b[1]))
+	return time.Date(y, m, day, h, min, sec, 0, loc), true
+}
+
+// EncodeTime encodes the wall clock of t to the 40 bits of MJD and BCD of
+// hours, minutes and seconds in b. It returns an error if the date of t is
+// out of range of MJD, i.e. from 1858-11-17 to 2038-04-22.
+func EncodeTime(b []byte, t time.Time) error {
+	y, m, d := t.Date()
+	if days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()/(24*60*60) + mjdUnixEpoch; days < 0 || days > 0xFFFF {
+		return fmt.Errorf("arib: %04d-%02d-%02d is out of range of MJD", y, m, d)
+	}
+	mjd := EncodeMJD(y, m, d)
+	b[0], b[1] = byte(mjd>>8), byte(mjd)
+	return encodeClock(b[2:5], t.Hour(), t.Minute(), t.Second())
+}
+
+// DecodeDuration decodes the 24 bits of BCD of hours, minutes and seconds,
+// e.g. duration. It returns false if the duration is undefined, i.e. all bits
+// are 1, not BCD, or minutes or seconds are more than 59.
+func DecodeDuration(b []byte) (time.Duration, bool) {
+	h, m, s, ok := decodeClock(b[:3])
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second, true
+}
+
+// EncodeDuration encodes d to the 24 bits of BCD of hours, minutes and
+// seconds in b. The fraction of a second is truncated. It returns an error if
+// d is negative or not less than 100 hours.
+func EncodeDuration(b []byte, d time.Duration) error {
+	if d < 0 || d >= 100*time.Hour {
+		return fmt.Errorf("arib: %v is out of range of duration", d)
+	}
+	s := int(d / time.Second)
+	return encodeClock(b[:3], s/3600, s/60%60, s%60)
+}
+
+// decodeClock decodes the 24 bits of BCD of hours, minutes and seconds. It
+// returns false if they are not BCD, or minutes or seconds are more than 59.
+func decodeClock(b []byte) (h, m, s int, ok bool) {
+	h, ok1 := DecodeBCD(b[0])
+	m, ok2 := DecodeBCD(b[1])
+	s, ok3 := DecodeBCD(b[2])
+	if !ok1 || !ok2 || !ok3 || m > 59 || s > 59 {
+		return 0, 0, 0, false
+	}
+	return h, m, s, true
+}
+
+// encodeClock encodes hours, minutes and seconds to the 24 bits of BCD in b.
+func encodeClock(b []byte, h, m, s int) error {
+	var err error
+	for i, n := range [3]int{h, m, s} {
+		if b[i], err = EncodeBCD(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeOffset decodes the 16 bits of BCD of hours and minutes, e.g.
+// local_time_offset.
+func decodeOffset(b []byte) (time.Duration, bool) {
+	h, ok1 := DecodeBCD(b[0])
+	m, ok2 := DecodeBCD(b[1])
+	if !ok1 || !ok2 || m > 59 {
+		return 0, false
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, true
+}
+
+// isUndefined reports whether all bits of b are 1.
+func isUndefined(b []byte) bool {
+	for _, v := range b {
+		if v != 0xFF {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,218 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMJD(t *testing.T) {
+	t.Parallel()
+
+	for i, tc := range []struct {
+		mjd   uint16
+		year  int
+		month time.Month
+		day   int
+	}{
+		{0x0000, 1858, time.November, 17},
+		{0x9E8B, 1970, time.January, 1},
+		{0xC079, 1993, time.October, 13},
+		{0xC993, 2000, time.February, 29},
+		{0xE19A, 2017, time.January, 1},
+		{0xFFFF, 2038, time.April, 22},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			y, m, d := DecodeMJD(tc.mjd)
+			if y != tc.year || m != tc.month || d != tc.day {
+				t.Errorf("%d: DecodeMJD(0x%04X) => %d-%d-%d, want %d-%d-%d", i, tc.mjd, y, m, d, tc.year, tc.month, tc.day)
+			}
+			if got := EncodeMJD(tc.year, tc.month, tc.day); got != tc.mjd {
+				t.Errorf("%d: EncodeMJD(%d, %d, %d) => 0x%04X, want 0x%04X", i, tc.year, tc.month, tc.day, got, tc.mjd)
+			}
+		})
+	}
+}
+
+func TestMJDRoundTrip(t *testing.T) {
+	for mjd := 0; mjd <= 0xFFFF; mjd++ {
+		if got := EncodeMJD(DecodeMJD(uint16(mjd))); got != uint16(mjd) {
+			t.Fatalf("EncodeMJD(DecodeMJD(0x%04X)) => 0x%04X", mjd, got)
+		}
+	}
+}
+
+func TestBCD(t *testing.T) {
+	for n := 0; n <= 99; n++ {
+		b, err := EncodeBCD(n)
+		if err != nil {
+			t.Fatalf("EncodeBCD(%d) => %v", n, err)
+		}
+		if got, ok := DecodeBCD(b); !ok || got != n {
+			t.Errorf("DecodeBCD(EncodeBCD(%d)) => %d, %v, want %d, true", n, got, ok, n)
+		}
+	}
+	for _, n := range []int{-1, 100, 123} {
+		if b, err := EncodeBCD(n); err == nil {
+			t.Errorf("EncodeBCD(%d) => 0x%02X, want error", n, b)
+		}
+	}
+	for _, b := range []byte{0x0A, 0xA0, 0x9F, 0xFF} {
+		if _, ok := DecodeBCD(b); ok {
+			t.Errorf("DecodeBCD(0x%02X) => true, want false", b)
+		}
+	}
+}
+
+func TestDecodeTime(t *testing.T) {
+	t.Parallel()
+
+	brt := time.FixedZone("BRT", -3*60*60)
+	for i, tc := range []struct {
+		b    []byte
+		loc  *time.Location
+		want time.Time
+		ok   bool
+	}{
+		{[]byte{0xC0, 0x79, 0x12, 0x45, 0x00}, JST, time.Date(1993, 10, 13, 12, 45, 0, 0, JST), true},
+		{[]byte{0xE1, 0x9A, 0x23, 0x59, 0x59}, brt, time.Date(2017, 1, 1, 23, 59, 59, 0, brt), true},
+		{[]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, JST, time.Time{}, false},
+		{[]byte{0xE1, 0x9A, 0x12, 0x3A, 0x00}, JST, time.Time{}, false},
+		{[]byte{0xE1, 0x9A, 0x99, 0x00, 0x00}, JST, time.Time{}, false},
+		{[]byte{0xE1, 0x9A, 0x24, 0x00, 0x00}, JST, time.Time{}, false},
+		{[]byte{0xE1, 0x9A, 0x12, 0x75, 0x00}, JST, time.Time{}, false},
+		{[]byte{0xE1, 0x9A, 0x12, 0x00, 0x60}, JST, time.Time{}, false},
+		{[]byte{0xE1, 0x9A, 0x21, 0x00, 0x00}, nil, time.Date(2017, 1, 1, 21, 0, 0, 0, JST), true},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := DecodeTime(tc.b, tc.loc)
+			if ok != tc.ok || !got.Equal(tc.want) {
+				t.Errorf("%d: DecodeTime(0x%X, %v) => %v, %v, want %v, %v", i, tc.b, tc.loc, got, ok, tc.want, tc.ok)
+			}
+			if !ok {
+				return
+			}
+			b := make([]byte, 5)
+			if err := EncodeTime(b, got); err != nil || string(b) != string(tc.b) {
+				t.Errorf("%d: EncodeTime(%v) => 0x%X, %v, want 0x%X", i, got, b, err, tc.b)
+			}
+		})
+	}
+}
+
+func TestDecodeTimeDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// 2017-03-12 03:30:00, 1 hour after the clocks go forward
+	b := []byte{0xE1, 0xE0, 0x03, 0x30, 0x00}
+	want := time.Date(2017, 3, 12, 3, 30, 0, 0, loc)
+	if got, ok := DecodeTime(b, loc); !ok || !got.Equal(want) {
+		t.Errorf("DecodeTime(0x%X, %v) => %v, %v, want %v, true", b, loc, got, ok, want)
+	}
+}
+
+func TestDecodeDuration(t *testing.T) {
+	t.Parallel()
+
+	for i, tc := range []struct {
+		b    []byte
+		want time.Duration
+		ok   bool
+	}{
+		{[]byte{0x01, 0x45, 0x30}, time.Hour + 45*time.Minute + 30*time.Second, true},
+		{[]byte{0x00, 0x00, 0x00}, 0, true},
+		{[]byte{0x99, 0x59, 0x59}, 99*time.Hour + 59*time.Minute + 59*time.Second, true},
+		{[]byte{0xFF, 0xFF, 0xFF}, 0, false},
+		{[]byte{0x01, 0x4A, 0x00}, 0, false},
+		{[]byte{0x01, 0x60, 0x00}, 0, false},
+		{[]byte{0x01, 0x00, 0x75}, 0, false},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := DecodeDuration(tc.b)
+			if ok != tc.ok || got != tc.want {
+				t.Errorf("%d: DecodeDuration(0x%X) => %v, %v, want %v, %v", i, tc.b, got, ok, tc.want, tc.ok)
+			}
+			if !ok {
+				return
+			}
+			b := make([]byte, 3)
+			if err := EncodeDuration(b, got); err != nil || string(b) != string(tc.b) {
+				t.Errorf("%d: EncodeDuration(%v) => 0x%X, %v, want 0x%X", i, got, b, err, tc.b)
+			}
+		})
+	}
+}
+
+func TestEncodeOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	for i, tm := range []time.Time{
+		time.Date(1858, 11, 16, 0, 0, 0, 0, time.UTC),
+		time.Date(2038, 4, 23, 0, 0, 0, 0, time.UTC),
+	} {
+		if err := EncodeTime(make([]byte, 5), tm); err == nil {
+			t.Errorf("%d: EncodeTime(%v) => nil, want error", i, tm)
+		}
+	}
+	for i, d := range []time.Duration{-time.Second, 100 * time.Hour} {
+		if err := EncodeDuration(make([]byte, 3), d); err == nil {
+			t.Errorf("%d: EncodeDuration(%v) => nil, want error", i, d)
+		}
+	}
+}
+
+func TestTimeAllocs(t *testing.T) {
+	b := []byte{0xE1, 0x9A, 0x12, 0x34, 0x56}
+	n := testing.AllocsPerRun(100, func() {
+		tm, _ := DecodeTime(b, JST)
+		EncodeTime(b, tm)
+		d, _ := DecodeDuration(b[2:])
+		EncodeDuration(b[2:], d)
+	})
+	if n != 0 {
+		t.Errorf("DecodeTime, EncodeTime, DecodeDuration and EncodeDuration => %v allocs, want 0", n)
+	}
+}
+
+func TestEventUndefinedTime(t *testing.T) {
+	e := Event{0x00, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x80, 0x00}
+	if got, ok := e.StartTimeIn(JST); ok || !got.IsZero() || !e.StartTime().IsZero() {
+		t.Errorf("StartTimeIn(JST), StartTime() => %v, %v, %v, want zero, false, zero", got, ok, e.StartTime())
+	}
+	if e.HasDuration() || e.Duration() != 0 {
+		t.Errorf("HasDuration(), Duration() => %v, %v, want false, 0", e.HasDuration(), e.Duration())
+	}
+
+	e = Event{0x00, 0x01, 0xE1, 0x9A, 0x21, 0x00, 0x00, 0x00, 0x30, 0x00, 0x80, 0x00}
+	want := time.Date(2017, 1, 1, 21, 0, 0, 0, JST)
+	if got, ok := e.StartTimeIn(nil); !ok || !got.Equal(want) || !e.StartTime().Equal(want) {
+		t.Errorf("StartTimeIn(nil), StartTime() => %v, %v, %v, want %v, true, %v", got, ok, e.StartTime(), want, want)
+	}
+	if !e.HasDuration() || e.Duration() != 30*time.Minute {
+		t.Errorf("HasDuration(), Duration() => %v, %v, want true, 30m", e.HasDuration(), e.Duration())
+	}
+}
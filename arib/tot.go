@@ -0,0 +1,150 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/drillbits/go-ts/ts"
+)
+
+// Table IDs of TDT and TOT
+const (
+	TableIDTDT ts.TableID = 0x70
+	TableIDTOT ts.TableID = 0x73
+)
+
+// TDT is a Time and Date Table.
+// time_date_section(){
+//     table_id                     8 uimsbf [0]
+//     section_syntax_indicator     1 bslbf  [1]
+//     reserved_future_use          1 bslbf  [1]
+//     reserved                     2 bslbf  [1]
+//     section_length              12 uimsbf [1-2]
+//     JST_time                    40 bslbf  [3-7]
+// }
+type TDT ts.PSI
+
+// ToTDT converts the section to the TDT.
+func ToTDT(b []byte) (TDT, error) {
+	b, err := section(b, TableIDTDT, "TDT", 8)
+	if err != nil {
+		return nil, err
+	}
+	return TDT(b), nil
+}
+
+// JSTTime returns the JST_time in loc, or JST if loc is nil.
+func (t TDT) JSTTime(loc *time.Location) time.Time {
+	jst, _ := DecodeTime(t[3:8], loc)
+	return jst
+}
+
+// TOT is a Time Offset Table.
+// time_offset_section(){
+//     table_id                     8 uimsbf [0]
+//     section_syntax_indicator     1 bslbf  [1]
+//     reserved_future_use          1 bslbf  [1]
+//     reserved                     2 bslbf  [1]
+//     section_length              12 uimsbf [1-2]
+//     JST_time                    40 bslbf  [3-7]
+//     reserved                     4 bslbf  [8]
+//     descriptors_loop_length     12 uimsbf [8-9]
+//     for (i=0;i<N;i++){
+//         descriptor()
+//     }
+//     CRC_32                      32 rpchof
+// }
+type TOT ts.PSI
+
+// ToTOT converts the section to the TOT. It returns ErrCRC if the CRC_32
+// does not match.
+func ToTOT(b []byte) (TOT, error) {
+	b, err := section(b, TableIDTOT, "TOT", 10+crc32size)
+	if err != nil {
+		return nil, err
+	}
+	t := TOT(b)
+	if 10+t.DescriptorsLoopLength()+crc32size > len(b) {
+		return nil, fmt.Errorf("arib: descriptors_loop_length %d overflows the TOT", t.DescriptorsLoopLength())
+	}
+	if err := checkCRC(b); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// JSTTime returns the JST_time in loc, or JST if loc is nil.
+func (t TOT) JSTTime(loc *time.Location) time.Time {
+	jst, _ := DecodeTime(t[3:8], loc)
+	return jst
+}
+
+// DescriptorsLoopLength returns the descriptors_loop_length.
+func (t TOT) DescriptorsLoopLength() int {
+	return int(uint16(t[9]&0xFF) | uint16(t[8]&0x0F)<<8)
+}
+
+// Descriptors returns the descriptors.
+func (t TOT) Descriptors() []ts.Descriptor {
+	return ts.Descriptors(t[10 : 10+t.DescriptorsLoopLength()])
+}
+
+// CRC32 returns the CRC_32.
+func (t TOT) CRC32() uint32 {
+	return binary.BigEndian.Uint32(t[len(t)-crc32size:])
+}
+
+// LocalTimeOffsets returns the local time offsets in the
+// local_time_offset_descriptors.
+func (t TOT) LocalTimeOffsets() []LocalTimeOffset {
+	var offsets []LocalTimeOffset
+	for _, d := range t.Descriptors() {
+		if IsLocalTimeOffsetDescriptor(d) {
+			offsets = append(offsets, LocalTimeOffsetDescriptor(d).Offsets()...)
+		}
+	}
+	return offsets
+}
+
+// section returns the section of the table_id trimmed to the
+// section_length, e.g. to drop the stuffing bytes.
+func section(b []byte, id ts.TableID, name string, min int) ([]byte, error) {
+	if len(b) < 3 {
+		return nil, fmt.Errorf("arib: %s is too short: %d bytes", name, len(b))
+	}
+	if ts.TableID(b[0]) != id {
+		return nil, fmt.Errorf("0x%02X is not a table_id for %s", b[0], name)
+	}
+	n := 3 + int(uint16(b[1]&0x0F)<<8|uint16(b[2]))
+	if n < min || n > len(b) {
+		return nil, fmt.Errorf("arib: %s has invalid section_length %d", name, n-3)
+	}
+	return b[:n], nil
+}
+
+// checkLoop returns an error if an entry of the loop overflows b. Each entry
+// is a byte of its tag and a byte of the length of its body, e.g. a
+// descriptor.
+func checkLoop(b []byte, name string) error {
+	for pos := 0; pos < len(b); pos += 2 + int(b[pos+1]) {
+		if pos+2 > len(b) || pos+2+int(b[pos+1]) > len(b) {
+			return fmt.Errorf("arib: %s overflows at %d", name, pos)
+		}
+	}
+	return nil
+}
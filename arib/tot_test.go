@@ -0,0 +1,132 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package arib
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// withCRC returns the section with the CRC_32.
+func withCRC(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, mpegCRC32(b))
+}
+
+func TestCRC32(t *testing.T) {
+	if got := mpegCRC32([]byte("123456789")); got != 0x0376E6E7 {
+		t.Errorf("mpegCRC32(123456789) => 0x%08X, want 0x0376E6E7", got)
+	}
+	b := withCRC([]byte{0x73, 0x70, 0x0A})
+	if err := checkCRC(b); err != nil {
+		t.Errorf("checkCRC(0x%X) => %v, want nil", b, err)
+	}
+	b[1] ^= 0x01
+	if err := checkCRC(b); err != ErrCRC {
+		t.Errorf("checkCRC(0x%X) => %v, want %v", b, err, ErrCRC)
+	}
+}
+
+func TestTDT(t *testing.T) {
+	// 2017-01-01 12:34:56 with stuffing bytes
+	tdt, err := ToTDT([]byte{0x70, 0x70, 0x05, 0xE1, 0x9A, 0x12, 0x34, 0x56, 0xFF, 0xFF})
+	if err != nil {
+		t.Fatalf("ToTDT(...) => %v", err)
+	}
+	if len(tdt) != 8 {
+		t.Errorf("len(ToTDT(...)) => %d, want 8", len(tdt))
+	}
+	want := time.Date(2017, 1, 1, 12, 34, 56, 0, JST)
+	if got := tdt.JSTTime(JST); !got.Equal(want) {
+		t.Errorf("JSTTime(JST) => %v, want %v", got, want)
+	}
+
+	for i, b := range [][]byte{
+		{0x70, 0x70},
+		{0x73, 0x70, 0x05, 0xE1, 0x9A, 0x12, 0x34, 0x56},
+		{0x70, 0x70, 0x06, 0xE1, 0x9A, 0x12, 0x34, 0x56},
+	} {
+		if _, err := ToTDT(b); err == nil {
+			t.Errorf("%d: ToTDT(0x%X) => nil, want error", i, b)
+		}
+	}
+}
+
+func TestTOT(t *testing.T) {
+	lto := []byte{
+		0x58, 0x1A,
+		// JPN, region 0, +01:00, 2017-03-26 02:00:00, +02:00
+		0x4A, 0x50, 0x4E, 0x02, 0x01, 0x00, 0xE1, 0xEE, 0x02, 0x00, 0x00, 0x02, 0x00,
+		// BRA, region 1, -03:00, 2017-10-15 00:00:00, -02:00
+		0x42, 0x52, 0x41, 0x07, 0x03, 0x00, 0xE2, 0xB9, 0x00, 0x00, 0x00, 0x02, 0x00,
+	}
+	b := []byte{0x73, 0x70, byte(5 + 2 + len(lto) + 4), 0xE1, 0x9A, 0x12, 0x34, 0x56, 0xF0, byte(len(lto))}
+	b = withCRC(append(b, lto...))
+
+	tot, err := ToTOT(b)
+	if err != nil {
+		t.Fatalf("ToTOT(...) => %v", err)
+	}
+	if want := time.Date(2017, 1, 1, 12, 34, 56, 0, JST); !tot.JSTTime(JST).Equal(want) {
+		t.Errorf("JSTTime(JST) => %v, want %v", tot.JSTTime(JST), want)
+	}
+	if got := len(tot.Descriptors()); got != 1 {
+		t.Errorf("Descriptors() => %d descriptors, want 1", got)
+	}
+	if got, want := tot.CRC32(), binary.BigEndian.Uint32(b[len(b)-4:]); got != want {
+		t.Errorf("CRC32() => 0x%08X, want 0x%08X", got, want)
+	}
+
+	offsets := tot.LocalTimeOffsets()
+	for i, want := range []struct {
+		country  string
+		region   int
+		offset   time.Duration
+		change   time.Time
+		next     time.Duration
+		polarity byte
+	}{
+		{"JPN", 0, time.Hour, time.Date(2017, 3, 26, 2, 0, 0, 0, JST), 2 * time.Hour, 0},
+		{"BRA", 1, -3 * time.Hour, time.Date(2017, 10, 15, 0, 0, 0, 0, JST), -2 * time.Hour, 1},
+	} {
+		if i >= len(offsets) {
+			t.Fatalf("LocalTimeOffsets() => %d offsets, want 2", len(offsets))
+		}
+		o := offsets[i]
+		if country, err := o.CountryCode(); err != nil || country != want.country {
+			t.Errorf("%d: CountryCode() => %s, %v, want %s", i, country, err, want.country)
+		}
+		if got := o.CountryRegionID(); got != want.region {
+			t.Errorf("%d: CountryRegionID() => %d, want %d", i, got, want.region)
+		}
+		if got := o.LocalTimeOffsetPolarity(); got != want.polarity {
+			t.Errorf("%d: LocalTimeOffsetPolarity() => %d, want %d", i, got, want.polarity)
+		}
+		if got := o.LocalTimeOffset(); got != want.offset {
+			t.Errorf("%d: LocalTimeOffset() => %v, want %v", i, got, want.offset)
+		}
+		if got := o.TimeOfChange(JST); !got.Equal(want.change) {
+			t.Errorf("%d: TimeOfChange(JST) => %v, want %v", i, got, want.change)
+		}
+		if got := o.NextTimeOffset(); got != want.next {
+			t.Errorf("%d: NextTimeOffset() => %v, want %v", i, got, want.next)
+		}
+	}
+
+	b[4] ^= 0x01
+	if _, err := ToTOT(b); err != ErrCRC {
+		t.Errorf("ToTOT(...) => %v, want %v", err, ErrCRC)
+	}
+}
@@ -0,0 +1,78 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import "golang.org/x/text/transform"
+
+// Final characters of control sequences introduced by CSI
+const (
+	GSM  = 0x42 // Character deformation
+	SWF  = 0x53 // Set writing format
+	CCC  = 0x54 // Composite character composition
+	SDF  = 0x56 // Set display format
+	SSM  = 0x57 // Character composition dot designation
+	SHS  = 0x58 // Set horizontal spacing
+	SVS  = 0x59 // Set vertical spacing
+	PLD  = 0x5B // Partially line down
+	PLU  = 0x5C // Partially line up
+	GAA  = 0x5D // Colouring block
+	SRC  = 0x5E // Raster colour command
+	SDP  = 0x5F // Set display position
+	ACPS = 0x61 // Active coordinate position set
+	TCC  = 0x62 // Switching control
+	ORN  = 0x63 // Ornament control
+	MDF  = 0x64 // Font
+	CFS  = 0x65 // Character font set
+	XCS  = 0x66 // External character set
+	SCR  = 0x67 // Scroll designation
+	PRA  = 0x68 // Built-in sound replay
+	ACS  = 0x69 // Alternative character set
+	UED  = 0x6A // Invisible data embedded control
+	RCS  = 0x6E // Raster colour designation
+	SCS  = 0x6F // Skip character set
+)
+
+// Command is a control function which has no output but matters to the
+// presentation of the text, such as a control sequence introduced by CSI.
+type Command struct {
+	// Offset is the offset in bytes of the decoded text where the command
+	// appears.
+	Offset int
+
+	// Code is the control code of the command, e.g. CSI.
+	Code byte
+
+	// Final is the final character of the control sequence introduced by
+	// CSI or TIME, e.g. TimeWait.
+	Final byte
+
+	// Params is the parameters of the command.
+	Params []int
+}
+
+// DecodeCommands decodes b like the decoder of XCSEncoding, and also returns
+// the commands in order of appearance.
+func DecodeCommands(b []byte) (string, []Command, error) {
+	var cmds []Command
+	d := newXCSDecoder()
+	d.onCommand = func(cmd Command) {
+		cmds = append(cmds, cmd)
+	}
+	s, _, err := transform.Bytes(d, b)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(s), cmds, nil
+}
@@ -0,0 +1,72 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeCommands(t *testing.T) {
+	for i, tc := range []struct {
+		name string
+		src  []byte
+		s    string
+		cmds []Command
+	}{
+		{
+			name: "NoCommand",
+			src:  []byte{0xAA, 0xAB},
+			s:    "おか",
+		},
+		{
+			name: "Caption",
+			src: []byte{
+				0x9B, 0x37, 0x20, 0x53, // SWF 7
+				0x9B, 0x31, 0x37, 0x30, 0x3B, 0x33, 0x30, 0x20, 0x5F, // SDP 170;30
+				0x9B, 0x36, 0x32, 0x30, 0x3B, 0x36, 0x30, 0x20, 0x56, // SDF 620;60
+				0x9B, 0x33, 0x36, 0x3B, 0x33, 0x36, 0x20, 0x57, // SSM 36;36
+				0x9B, 0x34, 0x20, 0x58, // SHS 4
+				0x9B, 0x32, 0x34, 0x20, 0x59, // SVS 24
+				0xAA, 0xAB,
+				0x9B, 0x31, 0x39, 0x30, 0x3B, 0x32, 0x31, 0x30, 0x20, 0x61, // ACPS 190;210
+				0xA2,
+			},
+			s: "おかあ",
+			cmds: []Command{
+				{0, CSI, SWF, []int{7}},
+				{0, CSI, SDP, []int{170, 30}},
+				{0, CSI, SDF, []int{620, 60}},
+				{0, CSI, SSM, []int{36, 36}},
+				{0, CSI, SHS, []int{4}},
+				{0, CSI, SVS, []int{24}},
+				{6, CSI, ACPS, []int{190, 210}},
+			},
+		},
+	} {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, cmds, err := DecodeCommands(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != tc.s || !reflect.DeepEqual(cmds, tc.cmds) {
+				t.Errorf("%d: DecodeCommands(0x%X) => %s, %v, want %s, %v", i, tc.src, s, cmds, tc.s, tc.cmds)
+			}
+		})
+	}
+}
@@ -25,18 +25,85 @@ import (
 var errInvalidARIBXCS = errors.New("arib: invalid external character set encoding")
 
 func newXCSDecoder() *xcsDecoder {
-	d := &xcsDecoder{}
+	d := &xcsDecoder{undefined: UndefinedChar}
 	d.init()
 	return d
 }
 
+// decoderState is the state of the decoder which a code changes. It is
+// restored when the output of the code does not fit in dst.
+type decoderState struct {
+	G       [4]graphicset.GraphicSet // G0, G1, G2, G3
+	des     [4]Designation
+	gl      int
+	gr      int
+	SS      graphicset.GraphicSet
+	ss      int // index of G of SS
+	style   Style
+	palette int
+
+	// mosaic is the pattern of non-spacing mosaic characters which are
+	// overlaid on the following character.
+	mosaic    graphicset.Mosaic
+	hasMosaic bool
+
+	// mosaicTrace is the record of the code which starts the pending
+	// mosaic, which is traced with the mosaic flushed at EOF.
+	mosaicTrace Trace
+}
+
 type xcsDecoder struct {
-	buf         []byte
-	G           [4]graphicset.GraphicSet // G0, G1, G2, G3
-	gl          int
-	gr          int
-	SS          graphicset.GraphicSet
-	isSmallSize bool
+	buf []byte
+	decoderState
+	drcs *graphicset.DRCSStore
+
+	// macros is the macro statements defined by MACRO.
+	macros     map[byte][]byte
+	macroDepth int
+
+	// latin reports whether the source is in the Latin character set of
+	// ISDB-Tb.
+	latin bool
+
+	// profile is the mapping of the additional symbols.
+	profile *graphicset.Profile
+
+	// width is the normalization of character widths.
+	width Width
+
+	// mosaicText returns the text of a mosaic character if it is not nil.
+	mosaicText func(m graphicset.Mosaic) string
+
+	// offset is the number of bytes of the source before the current src.
+	offset int
+
+	// lenient reports whether invalid codes are replaced with replacement
+	// instead of returning an error.
+	lenient     bool
+	replacement string
+
+	// undefined is the substitute of undefined characters.
+	undefined string
+
+	// written is the number of bytes written before the current code.
+	written int
+
+	// onCommand is called for each control sequence if it is not nil.
+	onCommand func(cmd Command)
+
+	// commands is the commands of the current code, which are passed to
+	// onCommand after its output is written.
+	commands []Command
+
+	// onOutput is called for each output of a code if it is not nil.
+	onOutput func(buf []byte)
+
+	// onTrace is called for each code if it is not nil.
+	onTrace func(t Trace)
+
+	// onError is called for each code replaced in lenient decoding if it is
+	// not nil.
+	onError func(err *DecodeError)
 }
 
 func (d *xcsDecoder) GL() graphicset.GraphicSet {
@@ -53,54 +120,162 @@ func (d *xcsDecoder) Reset() {
 
 func (d *xcsDecoder) init() {
 	d.buf = nil
-	d.G = [4]graphicset.GraphicSet{
-		graphicset.GSetMap[graphicset.Kanji],
-		graphicset.GSetMap[graphicset.Alphanumeric],
-		graphicset.GSetMap[graphicset.Hiragana],
-		graphicset.GSetMap[graphicset.Katakana], // TODO: Macro?
-	}
-	d.gl = 0
-	d.gr = 2
+	d.SS = nil
+	d.commands = nil
+	st := initialState
+	if d.latin {
+		st = latinState
+	}
+	for i, des := range st.G {
+		d.G[i] = d.graphicSet(des.Final)
+	}
+	d.des = st.G
+	d.gl = st.GL
+	d.gr = st.GR
+	d.style = initialStyle
+	d.palette = 0
+	d.macros = nil
+	d.macroDepth = 0
+	d.mosaic = 0
+	d.hasMosaic = false
+	d.mosaicTrace = Trace{}
+	d.offset = 0
+	d.written = 0
 }
 
 func (d *xcsDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
 	d.buf = src
+	defer func() {
+		d.offset += nSrc
+	}()
 	size := 0
 loop:
 	for ; nSrc < len(d.buf); nSrc += size {
-		var buf []byte
-		switch b := d.buf[nSrc]; {
-		case b <= SP:
-			buf, size, err = d.readC0(nSrc)
-
-		case b < DEL:
-			buf, size, err = d.readGL(nSrc)
-
-		case b <= CC1000:
-			buf, size, err = d.readC1(nSrc)
+		saved := d.decoderState
+		d.commands = d.commands[:0]
+		var replaced *DecodeError
 
-		case b != CC1515:
-			buf, size, err = d.readGR(nSrc)
-
-		default:
-			err = errInvalidARIBXCS
+		var buf []byte
+		buf, size, err = d.read(nSrc)
+		if err == transform.ErrShortSrc && atEOF {
+			// truncated code
+			size, err = len(d.buf)-nSrc, errInvalidARIBXCS
 		}
-		if err != nil {
-			break loop
+		if err != nil && err != transform.ErrShortSrc {
+			if !d.lenient && err != ErrUndefined {
+				d.decoderState = saved
+				err = d.decodeError(nSrc, size, err)
+				break loop
+			}
+			if d.onError != nil || d.onTrace != nil {
+				cur := d.decoderState
+				d.decoderState = saved
+				replaced = d.decodeError(nSrc, size, err).(*DecodeError)
+				d.decoderState = cur
+			}
+			if err == ErrUndefined {
+				buf = []byte(d.undefined)
+			} else {
+				buf = []byte(d.replacement)
+			}
+			err = nil
 		}
-
-		if nDst+len(buf) > len(dst) {
+		if err == nil && nDst+len(buf) > len(dst) {
 			err = transform.ErrShortDst
+		}
+		if err != nil {
+			// the code is read again with the next src or dst
+			d.decoderState = saved
 			break loop
 		}
+
 		nDst += copy(dst[nDst:], buf)
+		if d.onCommand != nil {
+			for _, cmd := range d.commands {
+				d.onCommand(cmd)
+			}
+		}
+		if replaced != nil && d.onError != nil {
+			d.onError(replaced)
+		}
+		if d.onTrace != nil {
+			t := d.trace(nSrc, size, buf, saved, replaced)
+			if d.hasMosaic && !saved.hasMosaic {
+				d.mosaicTrace = t
+			}
+			d.onTrace(t)
+		}
+		d.output(buf)
 	}
-	if atEOF && err == transform.ErrShortSrc {
-		err = errInvalidARIBXCS
+	if atEOF && err == nil && d.hasMosaic {
+		// no character follows the non-spacing mosaic
+		buf := d.text(d.mosaic)
+		if nDst+len(buf) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], d.flushMosaic())
+		if d.onTrace != nil {
+			t := d.mosaicTrace
+			t.State, t.Text = d.state(), string(buf)
+			d.onTrace(t)
+		}
+		d.output(buf)
 	}
 	return nDst, nSrc, err
 }
 
+// output passes buf written to dst to the hooks.
+func (d *xcsDecoder) output(buf []byte) {
+	d.written += len(buf)
+	if d.onOutput != nil && len(buf) > 0 {
+		d.onOutput(buf)
+	}
+}
+
+// state returns the state of code elements.
+func (d *xcsDecoder) state() State {
+	return State{G: d.des, GL: d.gl, GR: d.gr}
+}
+
+// decodeError returns the error of the code at pos with the state.
+func (d *xcsDecoder) decodeError(pos, size int, err error) error {
+	end := pos + size
+	if end > len(d.buf) {
+		end = len(d.buf)
+	}
+	return &DecodeError{
+		Offset: d.offset + pos,
+		Bytes:  append([]byte(nil), d.buf[pos:end]...),
+		State:  d.state(),
+		Err:    err,
+	}
+}
+
+// read reads the code at pos and returns its output and size. It returns
+// transform.ErrShortSrc if the code continues beyond the src, where the
+// readers see 0x00 for the missing bytes.
+func (d *xcsDecoder) read(pos int) ([]byte, int, error) {
+	var buf []byte
+	var size int
+	var err error
+	switch b := d.buf[pos]; {
+	case b <= SP:
+		buf, size, err = d.readC0(pos)
+	case b < DEL:
+		buf, size, err = d.readGL(pos)
+	case b <= CC1000:
+		buf, size, err = d.readC1(pos)
+	case b != CC1515:
+		buf, size, err = d.readGR(pos)
+	default:
+		return nil, 1, errInvalidARIBXCS
+	}
+	if pos+size > len(d.buf) {
+		return nil, 1, transform.ErrShortSrc
+	}
+	return buf, size, err
+}
+
 func (d *xcsDecoder) readC0(pos int) ([]byte, int, error) {
 	return d.readControlSet(pos)
 }
@@ -121,63 +296,115 @@ func (d *xcsDecoder) readControlSet(pos int) ([]byte, int, error) {
 		// skip with parameter
 		size++
 	case SS2:
-		d.SS = d.G[2]
+		d.SS, d.ss = d.G[2], 2
 	case ESC:
 		buf, size, err = d.readESC(pos)
 	case APS:
 		// skip with parameter
 		size += 2
 	case SS3:
-		d.SS = d.G[3]
+		d.SS, d.ss = d.G[3], 3
 	case SP:
-		if d.isSmallSize {
+		buf = []byte("　")
+		if d.latin || d.isSmallSize() {
 			buf = []byte(" ")
 		}
-		buf = []byte("　")
-	case SSZ, MSZ:
-		d.isSmallSize = true
+		if d.hasMosaic {
+			buf = append(d.flushMosaic(), buf...)
+		}
+	case BKF, RDF, GRF, YLF, BLF, MGF, CNF, WHF:
+		d.style.Foreground = d.palette<<4 | int(d.buf[pos]-BKF)
+	case SSZ:
+		d.style.Size = SizeSmall
+	case MSZ:
+		d.style.Size = SizeMiddle
 	case NSZ:
-		d.isSmallSize = false
+		d.style.Size = SizeNormal
 	case SZX:
 		p1 := d.paramOrNil(pos, 1)
 		size++
-		d.isSmallSize = p1 == 0x60
+		if sz, ok := szxSizes[p1]; ok {
+			d.style.Size = sz
+		}
 	case COL:
-		// skip with parameter
 		p1 := d.paramOrNil(pos, 1)
 		size++
 		if p1 == 0x20 {
-			// more parameter
+			// palette
+			p2 := d.paramOrNil(pos, 2)
 			size++
+			d.palette = int(p2 & 0x0F)
+			break
+		}
+		color := d.palette<<4 | int(p1&0x0F)
+		switch p1 & 0xF0 {
+		case 0x40:
+			d.style.Foreground = color
+		case 0x50:
+			d.style.Background = color
+		case 0x60:
+			d.style.HalfForeground = color
+		case 0x70:
+			d.style.HalfBackground = color
+		}
+	case FLC:
+		p1 := d.paramOrNil(pos, 1)
+		size++
+		switch p1 {
+		case 0x40:
+			d.style.Flash = FlashNormal
+		case 0x47:
+			d.style.Flash = FlashInverted
+		case 0x4F:
+			d.style.Flash = FlashNone
 		}
-	case FLC, POL, WMM, HLC, RPC:
+	case POL:
+		p1 := d.paramOrNil(pos, 1)
+		size++
+		switch p1 {
+		case 0x40:
+			d.style.Polarity = PolarityNormal
+		case 0x41:
+			d.style.Polarity = PolarityInverted1
+		case 0x42:
+			d.style.Polarity = PolarityInverted2
+		}
+	case HLC:
+		p1 := d.paramOrNil(pos, 1)
+		size++
+		d.style.Highlight = Highlight(p1 & 0x0F)
+	case STL:
+		d.style.Underline = true
+	case SPL:
+		d.style.Underline = false
+	case WMM, RPC:
 		// skip with parameter
 		size++
-	case MACRO:
-		// TODO: test
-		for i := pos + 1; d.paramOrNil(pos, i) != 0x4F; i++ {
+	case CDC:
+		// skip with parameters
+		size++
+		if d.paramOrNil(pos, 1) == 0x20 {
 			size++
 		}
+	case MACRO:
+		buf, size, err = d.readMACRO(pos)
 	case CSI:
-		// TODO
-		// return d.readCSI(src, n)
+		var cmd Command
+		cmd, size, err = d.readCSI(pos)
+		if err != nil {
+			break
+		}
+		if cmd.Final == ORN {
+			d.ornament(cmd.Params)
+		}
+		d.commands = append(d.commands, cmd)
 	case TIME:
-		// TODO: test
-		p1 := d.paramOrNil(pos, 1)
-		size++
-		if p1 == 0x20 {
-			// skip with parameter
-			size++
-		} else {
-			for i := pos + 2; ; i++ {
-				p := d.paramOrNil(pos, i)
-				if p < 0x40 && p > 0x43 {
-					size++
-				} else {
-					break
-				}
-			}
+		var cmd Command
+		cmd, size, err = d.readTIME(pos)
+		if err != nil {
+			break
 		}
+		d.commands = append(d.commands, cmd)
 	default:
 		// skip
 	}
@@ -185,8 +412,74 @@ func (d *xcsDecoder) readControlSet(pos int) ([]byte, int, error) {
 	return buf, size, err
 }
 
+func (d *xcsDecoder) isSmallSize() bool {
+	switch d.style.Size {
+	case SizeSmall, SizeMiddle, SizeTiny:
+		return true
+	}
+	return false
+}
+
+// ornament sets the ornament by the parameters of ORN.
+//
+//	ORN P1 [; P2]
+//
+// P2 is the colour of 4 digits, palette number and colour number.
+func (d *xcsDecoder) ornament(params []int) {
+	if len(params) == 0 {
+		return
+	}
+	d.style.Ornament = Ornament(params[0])
+	if len(params) > 1 {
+		d.style.OrnamentColor = params[1]/100<<4 | params[1]%100
+	}
+}
+
+// readCSI reads the control sequence introduced by CSI and reports its size.
+//
+//	CSI P11 .. P1i 0x3B .. Pn1 .. Pnk I1 F
+func (d *xcsDecoder) readCSI(pos int) (Command, int, error) {
+	cmd := Command{Offset: d.written, Code: CSI}
+	param, hasParam := 0, false
+	for i := pos + 1; i < len(d.buf); i++ {
+		switch b := d.buf[i]; {
+		case b >= 0x30 && b <= 0x39:
+			param = param*10 + int(b-0x30)
+			hasParam = true
+		case b == 0x3B:
+			// parameter separator
+			cmd.Params = append(cmd.Params, param)
+			param, hasParam = 0, false
+		case b == 0x20:
+			// intermediate character
+			if hasParam {
+				cmd.Params = append(cmd.Params, param)
+				param, hasParam = 0, false
+			}
+		case b >= 0x40 && b <= 0x6F:
+			// final character
+			if hasParam {
+				cmd.Params = append(cmd.Params, param)
+			}
+			cmd.Final = b
+			return cmd, i - pos + 1, nil
+		default:
+			return cmd, 1, fmt.Errorf("arib: CSI has invalid parameter 0x%02X", b)
+		}
+	}
+	return cmd, 1, transform.ErrShortSrc
+}
+
 func (d *xcsDecoder) readESC(pos int) ([]byte, int, error) {
 	size, err := 1, error(nil)
+	// an escape sequence is ESC I .. I F, where I is an intermediate byte
+	end := pos + 1
+	for end < len(d.buf) && d.buf[end] >= 0x20 && d.buf[end] <= 0x2F {
+		end++
+	}
+	if end >= len(d.buf) {
+		return nil, size, transform.ErrShortSrc
+	}
 
 	p1 := d.paramOrNil(pos, 1)
 	size++
@@ -195,7 +488,21 @@ func (d *xcsDecoder) readESC(pos int) ([]byte, int, error) {
 		var gi int
 		var gs graphicset.GraphicSet
 		size, gi, gs = d.designateGraphicSet(pos)
+		des := Designation{
+			Final: d.buf[pos+size-1],
+			DRCS:  d.buf[pos+size-2] == 0x20,
+		}
+		if gs == nil {
+			if !d.lenient {
+				return nil, size, fmt.Errorf("arib: unknown graphic set 0x%02X", des.Final)
+			}
+			gs = &invalidSet{1, d.replacement}
+			if p1 == 0x24 {
+				gs = &invalidSet{2, d.replacement}
+			}
+		}
 		d.G[gi] = gs
+		d.des[gi] = des
 	case p1 == 0x6E:
 		d.gl = 2
 	case p1 == 0x6F:
@@ -232,11 +539,11 @@ func (d *xcsDecoder) designateGraphicSet(pos int) (size, gi int, gs graphicset.G
 		case 0x20:
 			// DRCS
 			p3 := d.paramOrNil(pos, 3)
-			gs = graphicset.DRCSMap[p3]
+			gs = d.drcsSet(p3)
 			size++
 		default:
 			// G set
-			gs = graphicset.GSetMap[p2]
+			gs = d.graphicSet(p2)
 		}
 	case 0x24:
 		// 2 byte charset
@@ -253,26 +560,48 @@ func (d *xcsDecoder) designateGraphicSet(pos int) (size, gi int, gs graphicset.G
 				// DRCS
 				p4 := d.paramOrNil(pos, 4)
 				size++
-				gs = graphicset.DRCSMap[p4]
+				gs = d.drcsSet(p4)
 			default:
 				// G set
 				if p2 == 0x28 {
-					gs = graphicset.GSetMap[p2]
+					gs = d.graphicSet(p2)
 					size--
 				} else {
-					gs = graphicset.GSetMap[p3]
+					gs = d.graphicSet(p3)
 				}
 			}
 		default:
 			// G set
 			gi = 0
-			gs = graphicset.GSetMap[p2]
+			gs = d.graphicSet(p2)
 		}
 	}
 
 	return
 }
 
+// graphicSet returns the graphic set of the final byte with the profile.
+func (d *xcsDecoder) graphicSet(final byte) graphicset.GraphicSet {
+	if d.latin {
+		return graphicset.LatinSetMap[final]
+	}
+	if d.profile == nil {
+		return graphicset.GSetMap[final]
+	}
+	return d.profile.GraphicSet(final)
+}
+
+// drcsSet returns the DRCS designated by the final byte.
+func (d *xcsDecoder) drcsSet(final byte) graphicset.GraphicSet {
+	if final == graphicset.Macro {
+		return macroSet{}
+	}
+	if d.drcs == nil {
+		return graphicset.DRCSMap[final]
+	}
+	return d.drcs.Set(final)
+}
+
 func (d *xcsDecoder) readGL(n int) ([]byte, int, error) {
 	// SingleShift
 	var gs graphicset.GraphicSet
@@ -282,15 +611,62 @@ func (d *xcsDecoder) readGL(n int) ([]byte, int, error) {
 	} else {
 		gs = d.GL()
 	}
-	b, size := gs.Get(d.byteOrNil(n), d.byteOrNil(n+1))
-	return b, size, nil
+	return d.readGraphic(gs, d.byteOrNil(n), d.byteOrNil(n+1))
 }
 
 func (d *xcsDecoder) readGR(n int) ([]byte, int, error) {
-	b, size := d.GR().Get(d.byteOrNil(n)&0x7F, d.byteOrNil(n+1)&0x7F)
+	return d.readGraphic(d.GR(), d.byteOrNil(n)&0x7F, d.byteOrNil(n+1)&0x7F)
+}
+
+// readGraphic reads the code of the graphic set in 7 bits.
+func (d *xcsDecoder) readGraphic(gs graphicset.GraphicSet, b1, b2 byte) ([]byte, int, error) {
+	if isMacroSet(gs) {
+		b, err := d.expandMacro(b1)
+		return b, 1, err
+	}
+	b, size := gs.Get(b1, b2)
+	if !graphicset.Defined(gs, b1, b2) {
+		return nil, size, ErrUndefined
+	}
+	if ms, ok := gs.(*graphicset.MosaicSet); ok {
+		return d.readMosaic(ms, b1), 1, nil
+	}
+	if d.isSmallSize() {
+		b = narrow(b)
+	}
+	if d.hasMosaic && len(b) > 0 {
+		b = append(d.flushMosaic(), b...)
+	}
 	return b, size, nil
 }
 
+// readMosaic reads the code of the mosaic set. A non-spacing mosaic is
+// combined with the following mosaic, or precedes the following character.
+func (d *xcsDecoder) readMosaic(ms *graphicset.MosaicSet, b byte) []byte {
+	m, _ := ms.Mosaic(b)
+	m |= d.mosaic
+	if ms.NonSpacing {
+		d.mosaic, d.hasMosaic = m, true
+		return nil
+	}
+	d.mosaic, d.hasMosaic = 0, false
+	return d.text(m)
+}
+
+// flushMosaic returns the text of the pending non-spacing mosaic.
+func (d *xcsDecoder) flushMosaic() []byte {
+	m := d.mosaic
+	d.mosaic, d.hasMosaic = 0, false
+	return d.text(m)
+}
+
+func (d *xcsDecoder) text(m graphicset.Mosaic) []byte {
+	if d.mosaicText != nil {
+		return []byte(d.mosaicText(m))
+	}
+	return []byte(m.String())
+}
+
 func (d *xcsDecoder) byteOrNil(pos int) byte {
 	if pos < len(d.buf) {
 		return d.buf[pos]
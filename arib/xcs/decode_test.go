@@ -19,9 +19,13 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/drillbits/go-arib/arib/xcs/graphicset"
+	"golang.org/x/text/encoding"
 	"golang.org/x/text/transform"
 )
 
@@ -58,6 +62,16 @@ func TestDecode(t *testing.T) {
 			src:  []byte{0x1B, 0x7C, 0xA2, 0xCB, 0xE1, 0x21, 0x21, 0x1B, 0x7D, 0xAA, 0xB8, 0xE3, 0xEB, 0x34, 0x5D, 0xFB, 0x31, 0x73, 0xA4, 0x4C, 0x73, 0x42, 0x2B, 0xFC, 0x1B, 0x24, 0x3B, 0x7A, 0x56},
 			dst:  []byte("アニメ　おじゃる丸「遠い約束」【字】"),
 		},
+		{
+			name: "JISKanji",
+			src:  []byte{0x1B, 0x24, 0x39, 0x24, 0x77, 0x1B, 0x24, 0x2A, 0x3A, 0x1B, 0x7D, 0xA1, 0xA1},
+			dst:  []byte("か\u309A\U00020089"),
+		},
+		{
+			name: "ControlSequence",
+			src:  []byte{0x9B, 0x37, 0x20, 0x53, 0x9B, 0x31, 0x37, 0x30, 0x3B, 0x33, 0x30, 0x20, 0x5F, 0xAA, 0xAB},
+			dst:  []byte("おか"),
+		},
 		// TODO: Additional Kanji
 		// TODO: JIS X 0201 Katakana
 		// TODO: Hankaku alphanumeric
@@ -79,6 +93,388 @@ func TestDecode(t *testing.T) {
 	}
 }
 
+func TestDecodeWithDRCS(t *testing.T) {
+	drcs := graphicset.NewDRCSStore()
+	err := drcs.Load(graphicset.DataUnitDRCS1, []byte{0x02,
+		0x41, 0x21, 0x01, 0x00, 0x00, 0x08, 0x01, 0xAA,
+		0x41, 0x22, 0x01, 0x00, 0x00, 0x08, 0x01, 0x55,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, _ := drcs.Glyph(0x41, 0x21, 0x00)
+	drcs.Register(g.Hash(), "♪")
+
+	// ESC ( SP F designates DRCS-1 to G0
+	src := []byte{0x1B, 0x28, 0x20, 0x41, 0x21, 0xAA, 0x22, 0x23}
+	// ESC $ ( SP @ designates DRCS-0 to G0
+	src0 := []byte{0x1B, 0x24, 0x28, 0x20, 0x40, 0x21, 0x21, 0xAA}
+	for i, tc := range []struct {
+		dec *encoding.Decoder
+		src []byte
+		dst string
+	}{
+		{XCSEncoding.NewDecoder(), src, "〓お〓〓"},
+		{XCSEncoding.NewDecoder(), src0, "〓お"},
+		{NewDecoder(WithDRCS(drcs)), src, "♪お〓〓"},
+	} {
+		got, err := tc.dec.String(string(tc.src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.dst {
+			t.Errorf("%d: Decode(0x%X) => %s, want %s", i, tc.src, got, tc.dst)
+		}
+	}
+}
+
+func TestDecodeMosaic(t *testing.T) {
+	// G1 <- Mosaic A, G2 <- Mosaic C
+	designate := []byte{0x1B, 0x29, 0x32, 0x1B, 0x2A, 0x34}
+	for i, tc := range []struct {
+		dec *encoding.Decoder
+		src []byte
+		dst string
+	}{
+		{XCSEncoding.NewDecoder(), []byte{0x0E, 0x21, 0x3F, 0x35}, "\U0001FB00\U0001FB1D▌"},
+		{XCSEncoding.NewDecoder(), []byte{0xA1, 0x0E, 0x22}, "\U0001FB02"},
+		{XCSEncoding.NewDecoder(), []byte{0xA1, 0x20}, "\U0001FB00　"},
+		{XCSEncoding.NewDecoder(), []byte{0xA1}, "\U0001FB00"},
+		{NewDecoder(WithMosaicFunc(func(m graphicset.Mosaic) string {
+			return fmt.Sprintf("[%06b]", byte(m))
+		})), []byte{0x0E, 0x21, 0x7E}, "[000001][111110]"},
+	} {
+		src := append(append([]byte(nil), designate...), tc.src...)
+		got, err := tc.dec.String(string(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.dst {
+			t.Errorf("%d: Decode(0x%X) => %s, want %s", i, src, got, tc.dst)
+		}
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	state := initialState
+	state.G[1] = Designation{0x41, true}
+	state.GR = 3
+	for i, tc := range []struct {
+		src []byte
+		err *DecodeError
+		msg string
+	}{
+		{
+			[]byte{0xA2, 0xFF, 0xA2},
+			&DecodeError{1, []byte{0xFF}, initialState, errInvalidARIBXCS},
+			"arib: invalid external character set encoding at offset 1 (0xFF)",
+		},
+		{
+			[]byte{0xA2, 0x1B, 0x28, 0x7A, 0xA2},
+			&DecodeError{1, []byte{0x1B, 0x28, 0x7A}, initialState, errors.New("arib: unknown graphic set 0x7A")},
+			"arib: unknown graphic set 0x7A at offset 1 (0x1B287A)",
+		},
+		{
+			[]byte{0xA2, 0x9B, 0x31},
+			&DecodeError{1, []byte{0x9B, 0x31}, initialState, errInvalidARIBXCS},
+			"arib: invalid external character set encoding at offset 1 (0x9B31)",
+		},
+		{
+			[]byte{0x1B, 0x7C, 0x1B, 0x29, 0x20, 0x41, 0xFF},
+			&DecodeError{6, []byte{0xFF}, state, errInvalidARIBXCS},
+			"arib: invalid external character set encoding at offset 6 (0xFF)",
+		},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := XCSEncoding.NewDecoder().Bytes(tc.src)
+			var got *DecodeError
+			if !errors.As(err, &got) {
+				t.Fatalf("%d: Decode(0x%X) => _, %v, want _, %v", i, tc.src, err, tc.err)
+			}
+			if got.Offset != tc.err.Offset || !bytes.Equal(got.Bytes, tc.err.Bytes) || got.State != tc.err.State || got.Err.Error() != tc.err.Err.Error() || got.Error() != tc.msg {
+				t.Errorf("%d: Decode(0x%X) => _, %#v, want _, %#v", i, tc.src, got, tc.err)
+			}
+		})
+	}
+}
+
+func TestDecodeErrorOffset(t *testing.T) {
+	d := newXCSDecoder()
+	var dst [16]byte
+	if _, _, err := d.Transform(dst[:], []byte{0xA2, 0xA4}, false); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err := d.Transform(dst[:], []byte{0xA2, 0xFF}, true)
+	if e, ok := err.(*DecodeError); !ok || e.Offset != 3 {
+		t.Errorf("Transform(0xA2FF, true) => _, _, %v, want offset 3", err)
+	}
+}
+
+func TestDecodeLenient(t *testing.T) {
+	for i, tc := range []struct {
+		replacement string
+		src         []byte
+		dst         string
+	}{
+		{ReplacementChar, []byte{0xA2, 0xFF, 0xA2}, "あ\uFFFDあ"},
+		{ReplacementChar, []byte{0xA2, 0x9B, 0x31}, "あ\uFFFD"},
+		{"?", []byte{0xA2, 0x1B, 0x28, 0x7A, 0xA2, 0x21, 0x22}, "ああ??"},
+		{"", []byte{0x1B, 0x24, 0x29, 0x7A, 0x0E, 0x21, 0x22, 0x23, 0x24, 0x0F, 0x21, 0x22}, "、"},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewDecoder(WithLenient(tc.replacement)).Bytes(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.dst {
+				t.Errorf("%d: Decode(0x%X) => %s, want %s", i, tc.src, got, tc.dst)
+			}
+		})
+	}
+}
+
+func TestDecodeUndefined(t *testing.T) {
+	for i, tc := range []struct {
+		src     []byte
+		dst     string
+		offsets []int
+	}{
+		// additional symbols in the Kanji set
+		{[]byte{0x7A, 0x50, 0x7A, 0x21}, "【HV】⛌", nil},
+		{[]byte{0x7D, 0x4E, 0x75, 0x2F}, "\uFFFD𠮷", []int{0}},
+		// rows 87 to 89 are not defined in the Kanji set
+		{[]byte{0x77, 0x21, 0x1B, 0x28, 0x4A, 0x41, 0x1B, 0x2B, 0x3B, 0x1D, 0x7E, 0x7E}, "\uFFFDＡ\uFFFD", []int{0, 10}},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			// the substitute is the same in strict and lenient decoding
+			for _, lenient := range []bool{false, true} {
+				var offsets []int
+				opts := []Option{WithErrorFunc(func(err *DecodeError) {
+					if !errors.Is(err, ErrUndefined) {
+						t.Errorf("%d: error => %v, want %v", i, err, ErrUndefined)
+					}
+					offsets = append(offsets, err.Offset)
+				})}
+				if lenient {
+					opts = append(opts, WithLenient("?"))
+				}
+				got, err := NewDecoder(opts...).Bytes(tc.src)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != tc.dst || !reflect.DeepEqual(offsets, tc.offsets) {
+					t.Errorf("%d: Decode(0x%X) => %s, %v, want %s, %v", i, tc.src, got, offsets, tc.dst, tc.offsets)
+				}
+			}
+
+			got, err := XCSEncoding.NewDecoder().Bytes(tc.src)
+			if err != nil || string(got) != tc.dst {
+				t.Errorf("%d: Decode(0x%X) => %s, %v, want %s, <nil>", i, tc.src, got, err, tc.dst)
+			}
+
+			want := strings.Replace(tc.dst, UndefinedChar, "〓", -1)
+			got, err = NewDecoder(WithUndefined("〓")).Bytes(tc.src)
+			if err != nil || string(got) != want {
+				t.Errorf("%d: Decode(0x%X) => %s, %v, want %s, <nil>", i, tc.src, got, err, want)
+			}
+		})
+	}
+}
+
+func TestDecodeEveryCode(t *testing.T) {
+	t.Parallel()
+
+	type set struct {
+		des    []byte
+		double bool
+		opts   []Option
+	}
+	var sets []set
+	for final := range graphicset.GSetMap {
+		if graphicset.IsDoubleByte(final) {
+			sets = append(sets, set{[]byte{ESC, 0x24, final}, true, nil})
+		} else {
+			sets = append(sets, set{[]byte{ESC, 0x28, final}, false, nil})
+		}
+	}
+	for final := range graphicset.DRCSMap {
+		switch final {
+		case graphicset.Macro:
+			// macros are not characters
+		case graphicset.DRCS[0]:
+			sets = append(sets, set{[]byte{ESC, 0x24, 0x28, 0x20, final}, true, nil})
+		default:
+			sets = append(sets, set{[]byte{ESC, 0x28, 0x20, final}, false, nil})
+		}
+	}
+	for final := range graphicset.LatinSetMap {
+		sets = append(sets, set{[]byte{ESC, 0x28, final}, false, []Option{WithLatin()}})
+	}
+
+	for _, s := range sets {
+		var reported bool
+		opts := append(s.opts, WithErrorFunc(func(err *DecodeError) {
+			reported = true
+		}))
+		dec := NewDecoder(opts...)
+		for b1 := byte(0x21); b1 <= 0x7E; b1++ {
+			for b2 := byte(0x21); b2 <= 0x7E; b2++ {
+				src := append(append([]byte(nil), s.des...), b1)
+				if s.double {
+					src = append(src, b2)
+				}
+				reported = false
+				got, err := dec.Bytes(src)
+				if err != nil {
+					t.Fatalf("Decode(0x%X) => %v", src, err)
+				}
+				if len(got) == 0 && !reported {
+					t.Errorf("Decode(0x%X) => empty, want a character or an error reported", src)
+				}
+				if !s.double {
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeProfile(t *testing.T) {
+	src := []byte{0x7A, 0x56, 0x1B, 0x24, 0x3B, 0x7A, 0x5A, 0x7D, 0x2C}
+	for i, tc := range []struct {
+		profile *graphicset.Profile
+		dst     string
+	}{
+		{nil, "【字】【二】㍻"},
+		{graphicset.ProfileDefault, "【字】【二】㍻"},
+		{graphicset.ProfileUnicode, "🈑🈔㍻"},
+		{graphicset.ProfileBracket, "[字][二]平成"},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			var opts []Option
+			if tc.profile != nil {
+				opts = append(opts, WithProfile(tc.profile))
+			}
+			got, err := NewDecoder(opts...).Bytes(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.dst {
+				t.Errorf("%d: Decode(0x%X) => %s, want %s", i, src, got, tc.dst)
+			}
+		})
+	}
+}
+
+// decodeChunks decodes src by chunks of the size with dst of the size.
+func decodeChunks(src []byte, chunk, size int) (string, []Command, error) {
+	var out []byte
+	var cmds []Command
+	d := newXCSDecoder()
+	d.onCommand = func(cmd Command) {
+		cmds = append(cmds, cmd)
+	}
+	dst := make([]byte, size)
+	var buf []byte
+	for i := 0; ; {
+		end := i + chunk
+		if end > len(src) {
+			end = len(src)
+		}
+		buf = append(buf, src[i:end]...)
+		i = end
+		atEOF := i == len(src)
+		for {
+			nDst, nSrc, err := d.Transform(dst, buf, atEOF)
+			out = append(out, dst[:nDst]...)
+			buf = buf[nSrc:]
+			if err == transform.ErrShortDst && nDst+nSrc > 0 {
+				continue
+			}
+			if err == transform.ErrShortSrc && !atEOF {
+				break
+			}
+			if err != nil || atEOF {
+				return string(out), cmds, err
+			}
+			break
+		}
+	}
+}
+
+func TestDecodeStream(t *testing.T) {
+	src := []byte{
+		0x1B, 0x7C, 0xA2, 0xCB, 0xE1, 0x21, 0x21, 0x1B, 0x7D, 0xAA, 0xB8, 0xE3, 0xEB, 0x34, 0x5D, 0xFB, 0x31, 0x73,
+		0x90, 0x20, 0x41, 0x90, 0x52, // COL
+		0x9B, 0x31, 0x37, 0x30, 0x3B, 0x33, 0x30, 0x20, 0x5F, // SDP 170;30
+		0x0E, 0x41, 0x1D, 0x46, 0x0F, // LS1, SS3
+		0x95, 0x41, 0x21, 0xAA, 0xAB, 0x95, 0x4F, // MACRO
+		0x1B, 0x29, 0x32, 0x1B, 0x2A, 0x34, 0xA1, 0x0E, 0x22, 0x0F, // mosaic
+		0x1B, 0x24, 0x3B, 0x7A, 0x56,
+	}
+	dst, cmds, err := DecodeCommands(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dst != "アニメ　おじゃる丸「遠Ａテおか\U0001FB02【字】" {
+		t.Fatalf("Decode(0x%X) => %s", src, dst)
+	}
+	for chunk := 1; chunk <= len(src); chunk++ {
+		for _, size := range []int{9, 10, 13, 64} {
+			got, gotCmds, err := decodeChunks(src, chunk, size)
+			if err != nil {
+				t.Fatalf("chunk %d, dst %d: %v", chunk, size, err)
+			}
+			if got != dst || !reflect.DeepEqual(gotCmds, cmds) {
+				t.Errorf("chunk %d, dst %d: Decode(0x%X) => %s, %v, want %s, %v", chunk, size, src, got, gotCmds, dst, cmds)
+			}
+		}
+	}
+
+	tr := transform.NewReader(iotest.OneByteReader(bytes.NewReader(src)), XCSEncoding.NewDecoder())
+	got, err := ioutil.ReadAll(tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != dst {
+		t.Errorf("Decode(0x%X) by bytes => %s, want %s", src, got, dst)
+	}
+}
+
+func TestDecodeStreamShortSrc(t *testing.T) {
+	for i, src := range [][]byte{
+		{0xA2, 0x34},             // 2 byte character
+		{0xA2, 0x1B},             // ESC
+		{0xA2, 0x1B, 0x24},       // ESC 0x24
+		{0xA2, 0x1B, 0x24, 0x28}, // ESC 0x24 0x28
+		{0xA2, 0x1B, 0x28, 0x20}, // ESC 0x28 0x20
+		{0xA2, 0x90},             // COL
+		{0xA2, 0x90, 0x20},       // COL 0x20
+		{0xA2, 0x1C, 0x41},       // APS
+		{0xA2, 0x9B, 0x31},       // CSI
+		{0xA2, 0x95, 0x40, 0x21}, // MACRO
+	} {
+		d := newXCSDecoder()
+		var dst [16]byte
+		nDst, nSrc, err := d.Transform(dst[:], src, false)
+		if string(dst[:nDst]) != "あ" || nSrc != 1 || err != transform.ErrShortSrc {
+			t.Errorf("%d: Transform(0x%X, false) => %q, %d, %v, want %q, %d, %v", i, src, dst[:nDst], nSrc, err, "あ", 1, transform.ErrShortSrc)
+		}
+	}
+}
+
 // TODO
 func TestXCSDecoderReset(t *testing.T) {}
 
@@ -149,6 +545,35 @@ func TestXCSDecoderGetParamOrNil(t *testing.T) {
 // TODO
 func TestXCSDecoderReadControlSet(t *testing.T) {}
 
+func TestXCSDecoderReadCSI(t *testing.T) {
+	for i, tc := range []struct {
+		buf  []byte
+		pos  int
+		cmd  Command
+		size int
+		err  error
+	}{
+		{[]byte{0x9B, 0x20, 0x53}, 0, Command{0, CSI, SWF, nil}, 3, nil},
+		{[]byte{0x9B, 0x37, 0x20, 0x53}, 0, Command{0, CSI, SWF, []int{7}}, 4, nil},
+		{[]byte{0xAA, 0x9B, 0x39, 0x36, 0x30, 0x3B, 0x35, 0x34, 0x30, 0x20, 0x56, 0xAA}, 1, Command{0, CSI, SDF, []int{960, 540}}, 10, nil},
+		{[]byte{0x9B, 0x31, 0x3B, 0x20, 0x63}, 0, Command{0, CSI, ORN, []int{1}}, 5, nil},
+		{[]byte{0x9B, 0x31, 0x3B, 0x37, 0x36}, 0, Command{0, CSI, 0, []int{1}}, 1, transform.ErrShortSrc},
+		{[]byte{0x9B, 0x31, 0x0D}, 0, Command{0, CSI, 0, nil}, 1, errors.New("arib: CSI has invalid parameter 0x0D")},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			d := newXCSDecoder()
+			d.buf = tc.buf
+			cmd, size, err := d.readCSI(tc.pos)
+			if !reflect.DeepEqual(cmd, tc.cmd) || size != tc.size || fmt.Sprint(err) != fmt.Sprint(tc.err) {
+				t.Errorf("%d: buf 0x%X, readCSI(%d) => %v, %d, %v, want %v, %d, %v", i, tc.buf, tc.pos, cmd, size, err, tc.cmd, tc.size, tc.err)
+			}
+		})
+	}
+}
+
 func TestXCSDecoderReadESC(t *testing.T) {
 	d := newXCSDecoder()
 	gl, gr := d.GL(), d.GR()
@@ -230,7 +655,7 @@ func TestXCSDecoderDesignateGraphicSet(t *testing.T) {
 		{[]byte{0xAA, 0x1B, 0x28, 0x20, 0x41}, 1, 4, 0, graphicset.DRCSMap[0x41]},
 		{[]byte{0xAA, 0x1B, 0x29, 0x20, 0x42}, 1, 4, 1, graphicset.DRCSMap[0x42]},
 		{[]byte{0xAA, 0x1B, 0x2A, 0x20, 0x43}, 1, 4, 2, graphicset.DRCSMap[0x43]},
-		{[]byte{0xAA, 0x1B, 0x2B, 0x20, 0x70}, 1, 4, 3, graphicset.DRCSMap[0x70]},
+		{[]byte{0xAA, 0x1B, 0x2B, 0x20, 0x70}, 1, 4, 3, macroSet{}},
 		{[]byte{0xAA, 0x1B, 0x24, 0x28, 0x42}, 1, 3, 0, graphicset.GSetMap[0x28]},
 		{[]byte{0xAA, 0x1B, 0x24, 0x29, 0x39}, 1, 4, 1, graphicset.GSetMap[0x39]},
 		{[]byte{0xAA, 0x1B, 0x24, 0x2A, 0x3A}, 1, 4, 2, graphicset.GSetMap[0x3A]},
@@ -287,3 +712,40 @@ func TestXCSDecoderParamOrNil(t *testing.T) {
 		})
 	}
 }
+
+// epgText is a realistic text of an event in EPG.
+const epgText = "ニュース７　気象情報　全国の天気予報と週間予報、各地の気温の変化をお伝えします。" +
+	"特集は地域の伝統を守り続ける職人たちの物語。番組では視聴者の皆様からの投稿を募集しています。"
+
+func BenchmarkDecode(b *testing.B) {
+	src, err := XCSEncoding.NewEncoder().Bytes([]byte(epgText))
+	if err != nil {
+		b.Fatal(err)
+	}
+	dst := make([]byte, len(epgText)*2)
+	d := newXCSDecoder()
+	b.SetBytes(int64(len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Reset()
+		if _, _, err := d.Transform(dst, src, true); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	src := []byte(epgText)
+	dst := make([]byte, len(epgText)*2)
+	e := newXCSEncoder()
+	b.SetBytes(int64(len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Reset()
+		if _, _, err := e.Transform(dst, src, true); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -15,21 +15,514 @@
 package xcs
 
 import (
-	"errors"
+	"fmt"
+	"sort"
+	"unicode/utf8"
 
+	"github.com/drillbits/go-arib/arib/xcs/graphicset"
+	"golang.org/x/text/encoding"
 	"golang.org/x/text/transform"
 )
 
 func newXCSEncoder() *xcsEncoder {
-	return &xcsEncoder{}
+	e := &xcsEncoder{}
+	e.init()
+	return e
 }
 
+// codeElements is the state of code elements, G0 to G3 and their invocations.
+type codeElements struct {
+	G  [4]byte // final bytes of G0, G1, G2, G3
+	gl int
+	gr int
+}
+
+var initialCodeElements = codeElements{
+	G: [4]byte{
+		graphicset.Kanji,
+		graphicset.Alphanumeric,
+		graphicset.Hiragana,
+		graphicset.Katakana,
+	},
+	gl: 0,
+	gr: 2,
+}
+
+// designate appends the escape sequence which designates the graphic set to
+// G[gi].
+func (c *codeElements) designate(buf []byte, final byte, gi int) []byte {
+	c.G[gi] = final
+	if !graphicset.IsDoubleByte(final) {
+		return append(buf, ESC, byte(0x28+gi), final)
+	}
+	if gi == 0 {
+		return append(buf, ESC, 0x24, final)
+	}
+	return append(buf, ESC, 0x24, byte(0x28+gi), final)
+}
+
+// invokeGL appends the locking shift which invokes G[gi] to GL.
+func (c *codeElements) invokeGL(buf []byte, gi int) []byte {
+	c.gl = gi
+	switch gi {
+	case 0:
+		return append(buf, LS0)
+	case 1:
+		return append(buf, LS1)
+	case 2:
+		return append(buf, ESC, 0x6E) // LS2
+	default:
+		return append(buf, ESC, 0x6F) // LS3
+	}
+}
+
+// invokeGR appends the locking shift which invokes G[gi] to GR.
+func (c *codeElements) invokeGR(buf []byte, gi int) []byte {
+	c.gr = gi
+	switch gi {
+	case 1:
+		return append(buf, ESC, 0x7E) // LS1R
+	case 2:
+		return append(buf, ESC, 0x7D) // LS2R
+	default:
+		return append(buf, ESC, 0x7C) // LS3R
+	}
+}
+
+// singleShift appends the single shift for G[gi] which must be G2 or G3.
+func singleShift(buf []byte, gi int) []byte {
+	if gi == 2 {
+		return append(buf, SS2)
+	}
+	return append(buf, SS3)
+}
+
+func appendCode(buf []byte, c graphicset.Code, gr bool) []byte {
+	var mask byte
+	if gr {
+		mask = 0x80
+	}
+	buf = append(buf, c.B1|mask)
+	if c.Size == 2 {
+		buf = append(buf, c.B2|mask)
+	}
+	return buf
+}
+
+// invocation is a way to invoke a code element for a code.
+type invocation int
+
+const (
+	invokedGL invocation = iota
+	invokedGR
+	singleShifted
+	lockedGL
+	lockedGR
+)
+
+// move is a way to emit a code, designating the graphic set to G[gi] if it
+// is not designated yet and invoking G[gi].
+type move struct {
+	code graphicset.Code
+	gi   int
+	inv  invocation
+}
+
+// cost returns the number of bytes which apply emits for the move.
+func (c codeElements) cost(m move) int {
+	n := m.code.Size
+	if c.G[m.gi] != m.code.Final {
+		n += 3 // ESC, I, F
+		if graphicset.IsDoubleByte(m.code.Final) && m.gi != 0 {
+			n++ // ESC 0x24 I F
+		}
+	}
+	switch m.inv {
+	case singleShifted:
+		n++
+	case lockedGL:
+		if m.gi < 2 {
+			n++ // LS0, LS1
+		} else {
+			n += 2 // LS2, LS3
+		}
+	case lockedGR:
+		n += 2 // LS1R, LS2R, LS3R
+	}
+	return n
+}
+
+// next returns the state of code elements after the move.
+func (c codeElements) next(m move) codeElements {
+	c.G[m.gi] = m.code.Final
+	switch m.inv {
+	case lockedGL:
+		c.gl = m.gi
+	case lockedGR:
+		c.gr = m.gi
+	}
+	return c
+}
+
+// apply appends the codes for the move to buf.
+func (c *codeElements) apply(buf []byte, m move) []byte {
+	if c.G[m.gi] != m.code.Final {
+		buf = c.designate(buf, m.code.Final, m.gi)
+	}
+	switch m.inv {
+	case invokedGR:
+		return appendCode(buf, m.code, true)
+	case singleShifted:
+		buf = singleShift(buf, m.gi)
+	case lockedGL:
+		buf = c.invokeGL(buf, m.gi)
+	case lockedGR:
+		buf = c.invokeGR(buf, m.gi)
+		return appendCode(buf, m.code, true)
+	}
+	return appendCode(buf, m.code, false)
+}
+
+// moves calls fn for each move which emits one of the codes, in order of
+// preference.
+func (c codeElements) moves(codes []graphicset.Code, fn func(m move)) {
+	for _, code := range codes {
+		designatedAt := -1
+		for gi, final := range c.G {
+			if final == code.Final {
+				designatedAt = gi
+				break
+			}
+		}
+		for gi := 0; gi < 4; gi++ {
+			if designatedAt >= 0 && gi != designatedAt {
+				// never designate a graphic set twice
+				continue
+			}
+			designated := gi == designatedAt
+			if designated && gi == c.gl {
+				fn(move{code, gi, invokedGL})
+			}
+			if designated && gi == c.gr {
+				fn(move{code, gi, invokedGR})
+			}
+			if gi >= 2 {
+				fn(move{code, gi, singleShifted})
+			}
+			if gi != c.gr && gi != 0 {
+				fn(move{code, gi, lockedGR})
+			}
+			if gi != c.gl {
+				fn(move{code, gi, lockedGL})
+			}
+			if !designated && (gi == c.gl || gi == c.gr) {
+				// designate to the invoked code element directly
+				inv := invokedGL
+				if gi == c.gr {
+					inv = invokedGR
+				}
+				fn(move{code, gi, inv})
+			}
+		}
+	}
+}
+
+// token is a part of text which is encoded as a code.
+type token struct {
+	size  int               // length of the text in bytes
+	codes []graphicset.Code // nil for SP
+	small bool              // encoded in MSZ to be decoded in half-width
+}
+
+// tokenize splits s into tokens by the longest match, up to the token which
+// starts at limit. It reports the length of s which is tokenized. A
+// half-width character is encoded as its full-width character in MSZ.
+func tokenize(s string, limit int) ([]token, int, error) {
+	var tokens []token
+	n := 0
+	for n < limit {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if r == utf8.RuneError && size <= 1 {
+			return tokens, n, encoding.ErrInvalidUTF8
+		}
+		if r == '　' || r == ' ' {
+			tokens = append(tokens, token{size: size, small: r == ' '})
+			n += size
+			continue
+		}
+		if m, codes := graphicset.Match(s[n:]); m > 0 {
+			tokens = append(tokens, token{size: m, codes: codes})
+			n += m
+			continue
+		}
+		if w := wideRune(r); w != 0 {
+			if m, codes := graphicset.Match(string(w)); m == utf8.RuneLen(w) {
+				tokens = append(tokens, token{size: size, codes: codes, small: true})
+				n += size
+				continue
+			}
+		}
+		return tokens, n, fmt.Errorf("arib: xcs encoding does not support %q", r)
+	}
+	return tokens, n, nil
+}
+
+// resizes returns the number of MSZ and NSZ which switch the sizes of the
+// tokens from the size.
+func resizes(tokens []token, small bool) int {
+	n := 0
+	for _, t := range tokens {
+		if t.small != small {
+			small = t.small
+			n++
+		}
+	}
+	return n
+}
+
+// distance returns the number of bytes to change the state of code elements
+// to the other state.
+func (c codeElements) distance(o codeElements) int {
+	n := 0
+	for gi, final := range o.G {
+		if c.G[gi] == final {
+			continue
+		}
+		n += 3 // ESC, I, F
+		if graphicset.IsDoubleByte(final) && gi != 0 {
+			n++ // ESC 0x24 I F
+		}
+	}
+	if c.gl != o.gl {
+		if o.gl < 2 {
+			n++ // LS0, LS1
+		} else {
+			n += 2 // LS2, LS3
+		}
+	}
+	if c.gr != o.gr {
+		n += 2 // LS1R, LS2R, LS3R
+	}
+	return n
+}
+
+// frontier is the maximum number of the states which the planner keeps after
+// a token. It keeps the cheapest states which no other kept state reaches
+// with no more cost, so it takes linear time in the number of tokens.
+const frontier = 8
+
+// planNode is a state of code elements after a token, and the move from the
+// previous state to encode the token.
+type planNode struct {
+	state codeElements
+	cost  int
+	prev  *planNode
+	t     token
+	m     move // zero for SP
+}
+
+// planner finds the moves which encode the tokens with the fewest bytes.
+type planner struct {
+	root  *planNode   // node of the last committed token
+	nodes []*planNode // states after the last token
+	index map[codeElements]int
+	next  []planNode
+	trail []*planNode
+}
+
+func newPlanner(c codeElements) *planner {
+	root := &planNode{state: c}
+	return &planner{
+		root:  root,
+		nodes: []*planNode{root},
+		index: map[codeElements]int{},
+	}
+}
+
+// push plans the token after the tokens pushed before.
+func (p *planner) push(t token) {
+	if t.codes == nil {
+		// SP does not depend on code elements
+		for i, n := range p.nodes {
+			p.nodes[i] = &planNode{state: n.state, cost: n.cost + 1, prev: n, t: t}
+		}
+		return
+	}
+
+	next := p.next[:0]
+	for state := range p.index {
+		delete(p.index, state)
+	}
+	for _, n := range p.nodes {
+		n.state.moves(t.codes, func(m move) {
+			state := n.state.next(m)
+			cost := n.cost + n.state.cost(m)
+			if i, ok := p.index[state]; ok {
+				if cost < next[i].cost {
+					next[i] = planNode{state, cost, n, t, m}
+				}
+				return
+			}
+			p.index[state] = len(next)
+			next = append(next, planNode{state, cost, n, t, m})
+		})
+	}
+	p.next = next
+
+	// drop the states which one of the cheaper states can reach with no more
+	// cost
+	sort.SliceStable(next, func(i, j int) bool { return next[i].cost < next[j].cost })
+	p.nodes = p.nodes[:0]
+prune:
+	for i := range next {
+		for _, n := range p.nodes {
+			if n.cost+n.state.distance(next[i].state) <= next[i].cost {
+				continue prune
+			}
+		}
+		n := next[i]
+		p.nodes = append(p.nodes, &n)
+		if len(p.nodes) == frontier {
+			break
+		}
+	}
+}
+
+// best returns the cheapest state after the last token.
+func (p *planner) best() *planNode {
+	best := p.nodes[0]
+	for _, n := range p.nodes {
+		if n.cost < best.cost {
+			best = n
+		}
+	}
+	return best
+}
+
+// commit returns the nodes of the tokens which every state after the last
+// token is reached through, in order, and drops them from the planner. At
+// EOF, it returns the nodes to the cheapest state.
+func (p *planner) commit(atEOF bool) []*planNode {
+	var end *planNode
+	if atEOF {
+		end = p.best()
+		p.nodes = append(p.nodes[:0], end)
+	} else {
+		// all the states are reached through the root at least
+		ns := append(p.trail[:0], p.nodes...)
+		for end == nil {
+			end = ns[0]
+			for i, n := range ns {
+				if n != end {
+					end = nil
+				}
+				ns[i] = n.prev
+			}
+		}
+		p.trail = ns[:0]
+	}
+
+	var nodes []*planNode
+	for n := end; n != p.root; n = n.prev {
+		nodes = append(nodes, n)
+	}
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+	p.root = end
+	end.prev = nil
+	return nodes
+}
+
+// EncodedLen returns the number of bytes which the xcs encoding needs for s.
+func EncodedLen(s string) (int, error) {
+	tokens, _, err := tokenize(s, len(s))
+	if err != nil {
+		return 0, err
+	}
+	p := newPlanner(initialCodeElements)
+	for _, t := range tokens {
+		p.push(t)
+	}
+	return p.best().cost + resizes(tokens, false), nil
+}
+
+// xcsEncoder writes the codes of the tokens once every state which the
+// planner keeps is reached through them, so its output is the same as
+// EncodedLen reports however the src is split. It holds the tokens until then,
+// and the whole string at worst.
 type xcsEncoder struct {
-	transform.NopResetter
+	codeElements
+	small   bool // MSZ is in effect
+	planner *planner
+	out     []byte // encoded bytes not written yet
+}
+
+func (e *xcsEncoder) Reset() {
+	e.init()
+}
+
+func (e *xcsEncoder) init() {
+	e.codeElements = initialCodeElements
+	e.small = false
+	e.planner = newPlanner(e.codeElements)
+	e.out = e.out[:0]
+}
+
+func (e *xcsEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if nDst = e.flush(dst); len(e.out) > 0 {
+		return nDst, 0, transform.ErrShortDst
+	}
+
+	limit := len(src)
+	if !atEOF {
+		// the longest match may continue in the next src
+		limit -= graphicset.MaxMatchLen()
+	}
+	if limit > 0 {
+		var tokens []token
+		tokens, nSrc, err = tokenize(string(src), limit)
+		for _, t := range tokens {
+			e.planner.push(t)
+		}
+		if err != nil {
+			return nDst, nSrc, err
+		}
+	}
+
+	e.emit(e.planner.commit(atEOF))
+	nDst += e.flush(dst[nDst:])
+	if len(e.out) > 0 {
+		return nDst, nSrc, transform.ErrShortDst
+	}
+	if !atEOF && nSrc < len(src) {
+		err = transform.ErrShortSrc
+	}
+	return nDst, nSrc, err
+}
+
+// emit appends the codes of the planned tokens to the output.
+func (e *xcsEncoder) emit(nodes []*planNode) {
+	for _, n := range nodes {
+		if n.t.small != e.small {
+			e.small = n.t.small
+			if n.t.small {
+				e.out = append(e.out, MSZ)
+			} else {
+				e.out = append(e.out, NSZ)
+			}
+		}
+		if n.t.codes == nil {
+			e.out = append(e.out, SP)
+			continue
+		}
+		e.out = e.codeElements.apply(e.out, n.m)
+	}
 }
 
-func (*xcsEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
-	// TODO
-	err = errors.New("arib: xcs encoding not supported")
-	return
+// flush writes the output to dst as much as possible.
+func (e *xcsEncoder) flush(dst []byte) int {
+	n := copy(dst, e.out)
+	e.out = e.out[:copy(e.out, e.out[n:])]
+	return n
 }
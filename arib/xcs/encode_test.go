@@ -16,7 +16,12 @@ package xcs
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"golang.org/x/text/transform"
@@ -25,8 +30,193 @@ import (
 var _ transform.Transformer = (*xcsEncoder)(nil)
 
 func TestEncode(t *testing.T) {
-	s := "おかあさんといっしょ【字】"
-	exp := "arib: xcs encoding not supported"
+	for i, tc := range []struct {
+		name string
+		src  []byte
+		dst  []byte
+	}{
+		{
+			name: "HiraganaOnly",
+			src:  []byte("おかあさんといっしょ"),
+			dst:  []byte{0xAA, 0xAB, 0xA2, 0xB5, 0xF3, 0xC8, 0xA4, 0xC3, 0xB7, 0xE7},
+		},
+		{
+			name: "AdditionalSymbols",
+			src:  []byte("おかあさんといっしょ【字】"),
+			dst:  []byte{0xAA, 0xAB, 0xA2, 0xB5, 0xF3, 0xC8, 0xA4, 0xC3, 0xB7, 0xE7, 0x1B, 0x24, 0x3B, 0x7A, 0x56},
+		},
+		{
+			name: "AlphanumericAndKatakana",
+			src:  []byte("Ｅテレ２３５５"),
+			dst:  []byte{0x0E, 0x45, 0x1D, 0x46, 0x1D, 0x6C, 0x32, 0x33, 0x35, 0x35},
+		},
+		{
+			name: "Kanji",
+			src:  []byte("遠い約束"),
+			dst:  []byte{0x31, 0x73, 0xA4, 0x4C, 0x73, 0x42, 0x2B},
+		},
+		{
+			name: "KatakanaOnly",
+			src:  []byte("パズドラクロス"),
+			dst:  []byte{0x1B, 0x7C, 0xD1, 0xBA, 0xC9, 0xE9, 0xAF, 0xED, 0xB9},
+		},
+		{
+			name: "SingleShift",
+			src:  []byte("あテあ"),
+			dst:  []byte{0xA2, 0x1D, 0x46, 0xA2},
+		},
+		{
+			name: "IdeographicSpace",
+			src:  []byte("アニメ　"),
+			dst:  []byte{0x1B, 0x7C, 0xA2, 0xCB, 0xE1, 0x20},
+		},
+		{
+			name: "HalfWidth",
+			src:  []byte("a b"),
+			dst:  []byte{0x89, 0x0E, 0x61, 0x20, 0x62},
+		},
+		{
+			name: "HalfWidthAndFullWidth",
+			src:  []byte("ABＣ"),
+			dst:  []byte{0x89, 0x0E, 0x41, 0x42, 0x8A, 0x43},
+		},
+	} {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := transform.NewReader(bytes.NewReader(tc.src), XCSEncoding.NewEncoder())
+			got, err := ioutil.ReadAll(tr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tc.dst) {
+				t.Errorf("%d: Encode(%s) => 0x%X, want 0x%X", i, string(tc.src), got, tc.dst)
+			}
+		})
+	}
+}
+
+func TestEncodeLongText(t *testing.T) {
+	// longer than the buffers of the reader
+	s := strings.Repeat("アニメ　おじゃる丸「遠い約束」【字】", 100)
+
+	tr := transform.NewReader(strings.NewReader(s), XCSEncoding.NewEncoder())
+	encoded, err := ioutil.ReadAll(tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := XCSEncoding.NewDecoder().Bytes(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(decoded) != s {
+		t.Errorf("Decode(Encode(%s)) => %s", s, decoded)
+	}
+}
+
+// chunkReader reads at most n bytes at a time.
+type chunkReader struct {
+	r io.Reader
+	n int
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(p) > r.n {
+		p = p[:r.n]
+	}
+	return r.r.Read(p)
+}
+
+func TestEncodeChunked(t *testing.T) {
+	// the best plan of a part of these texts is not a part of the best plan
+	// of the whole text
+	parts := []string{"あ", "テ", "ア", "パ", "遠", "Ｅ", "２", "【字】", "　", "「", "ン", "ス", "か", "約"}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 2; i++ {
+		var b strings.Builder
+		for b.Len() < 1500 {
+			p := parts[rnd.Intn(len(parts))]
+			for j := rnd.Intn(4); j >= 0; j-- {
+				b.WriteString(p)
+			}
+		}
+		s := b.String()
+
+		whole, _, err := transform.String(XCSEncoding.NewEncoder(), s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := EncodedLen(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(whole) != n {
+			t.Errorf("%d: len(Encode(s)) => %d, want %d", i, len(whole), n)
+		}
+		for _, size := range []int{1, 100} {
+			tr := transform.NewReader(&chunkReader{strings.NewReader(s), size}, XCSEncoding.NewEncoder())
+			chunked, err := ioutil.ReadAll(&chunkReader{tr, size})
+			if err != nil {
+				t.Fatalf("%d: Encode(s) by %d bytes occurs error %s", i, size, err)
+			}
+			if !bytes.Equal(chunked, []byte(whole)) {
+				t.Errorf("%d: Encode(s) by %d bytes => %d bytes, want %d bytes", i, size, len(chunked), len(whole))
+			}
+		}
+	}
+}
+
+func TestEncodeStream(t *testing.T) {
+	src := []byte(strings.Repeat(epgText, 20))
+	e := newXCSEncoder()
+	dst := make([]byte, len(src))
+	nDst, nSrc, err := e.Transform(dst, src[:len(src)/2], false)
+	if err != nil && err != transform.ErrShortSrc {
+		t.Fatal(err)
+	}
+	if nDst == 0 {
+		t.Errorf("Transform(dst, src[:%d], false) => %d, %d, want the output before EOF", len(src)/2, nDst, nSrc)
+	}
+}
+
+func TestEncodedLen(t *testing.T) {
+	for i, tc := range []struct {
+		s   string
+		n   int
+		err error
+	}{
+		{"", 0, nil},
+		{"おかあさんといっしょ", 10, nil},
+		{"おかあさんといっしょ【字】", 15, nil},
+		{"パズドラクロス", 9, nil},
+		{"あテあ", 4, nil},
+		{"アニメ　おじゃる丸「遠い約束」【字】", 27, nil},
+		{"ABC", 5, nil},
+		{" ", 2, nil},
+		{"NHK ニュース", 13, nil},
+		{"\u0000", 0, errors.New(`arib: xcs encoding does not support '\x00'`)},
+	} {
+		n, err := EncodedLen(tc.s)
+		if n != tc.n || fmt.Sprint(err) != fmt.Sprint(tc.err) {
+			t.Errorf("%d: EncodedLen(%s) => %d, %v, want %d, %v", i, tc.s, n, err, tc.n, tc.err)
+		}
+		if err != nil {
+			continue
+		}
+		encoded, _, err := transform.String(XCSEncoding.NewEncoder(), tc.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(encoded) != n {
+			t.Errorf("%d: len(Encode(%s)) => %d, want %d", i, tc.s, len(encoded), n)
+		}
+	}
+}
+
+func TestEncodeUnsupported(t *testing.T) {
+	s := "おかあさん\u0000"
+	exp := `arib: xcs encoding does not support '\x00'`
 
 	tr := transform.NewReader(bytes.NewReader([]byte(s)), XCSEncoding.NewEncoder())
 	_, err := ioutil.ReadAll(tr)
@@ -34,3 +224,32 @@ func TestEncode(t *testing.T) {
 		t.Errorf("Encode(%s) occurs error %s, want %s", s, err, exp)
 	}
 }
+
+func TestEncodeRoundTrip(t *testing.T) {
+	for i, s := range []string{
+		"おかあさんといっしょ【字】",
+		"パズドラクロス",
+		"Ｅテレ２３５５",
+		"アニメ　おじゃる丸「遠い約束」【字】",
+		"ニュース７　【二】【デ】㍻３０年",
+		"\U00020B9Fる\U00020089か\u309A",
+		"ABC",
+		"a b",
+		" ",
+		"NHK ニュース７　ｶﾞｯﾂ",
+	} {
+		encoded, _, err := transform.String(XCSEncoding.NewEncoder(), s)
+		if err != nil {
+			t.Errorf("%d: Encode(%s) occurs error %s", i, s, err)
+			continue
+		}
+		decoded, _, err := transform.String(XCSEncoding.NewDecoder(), encoded)
+		if err != nil {
+			t.Errorf("%d: Decode(0x%X) occurs error %s", i, encoded, err)
+			continue
+		}
+		if decoded != s {
+			t.Errorf("%d: Decode(Encode(%s)) => %s, want %s", i, s, decoded, s)
+		}
+	}
+}
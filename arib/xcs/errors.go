@@ -0,0 +1,110 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/drillbits/go-arib/arib/xcs/graphicset"
+)
+
+// ReplacementChar is the default replacement of invalid codes in lenient
+// decoding.
+const ReplacementChar = "�"
+
+// UndefinedChar is the default substitute of undefined characters in both
+// strict and lenient decoding, see WithUndefined.
+const UndefinedChar = ReplacementChar
+
+// ErrUndefined is the error of a code which is not defined in its graphic set,
+// e.g. a gap of the additional symbols. It is not returned by the decoder but
+// passed to the function of WithErrorFunc.
+var ErrUndefined = errors.New("arib: undefined character")
+
+// Designation is a graphic set designated to a code element.
+type Designation struct {
+	// Final is the final byte of the graphic set.
+	Final byte
+
+	// DRCS reports whether the graphic set is DRCS.
+	DRCS bool
+}
+
+// State is the state of code elements of the decoder.
+type State struct {
+	G  [4]Designation // G0, G1, G2, G3
+	GL int            // index of G invoked to GL
+	GR int            // index of G invoked to GR
+}
+
+var initialState = State{
+	G: [4]Designation{
+		{Final: graphicset.Kanji},
+		{Final: graphicset.Alphanumeric},
+		{Final: graphicset.Hiragana},
+		// Katakana instead of Macro, which is the initial G3 only for captions
+		{Final: graphicset.Katakana},
+	},
+	GL: 0,
+	GR: 2,
+}
+
+// latinState is the initial state of the Latin character set of ISDB-Tb.
+var latinState = State{
+	G: [4]Designation{
+		{Final: graphicset.Alphanumeric},
+		{Final: graphicset.Alphanumeric},
+		{Final: graphicset.LatinExtension},
+		{Final: graphicset.LatinExtension},
+	},
+	GL: 0,
+	GR: 2,
+}
+
+// DecodeError is an error of decoding at a code of the source.
+type DecodeError struct {
+	// Offset is the offset in bytes of the code in the source.
+	Offset int
+
+	// Bytes is the code which is not decodable.
+	Bytes []byte
+
+	// State is the state of the decoder at the code.
+	State State
+
+	// Err is the cause of the error.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v at offset %d (0x%X)", e.Err, e.Offset, e.Bytes)
+}
+
+// Unwrap returns the cause of the error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// invalidSet is the graphic set of an unknown final byte in lenient
+// decoding. It replaces each code with the replacement.
+type invalidSet struct {
+	size        int
+	replacement string
+}
+
+func (s *invalidSet) Get(_, _ byte) ([]byte, int) {
+	return []byte(s.replacement), s.size
+}
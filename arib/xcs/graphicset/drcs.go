@@ -0,0 +1,272 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+const (
+	// DataUnitDRCS1 is a data unit parameter of 1 byte DRCS.
+	DataUnitDRCS1 = 0x30
+
+	// DataUnitDRCS2 is a data unit parameter of 2 byte DRCS.
+	DataUnitDRCS2 = 0x31
+)
+
+// DefaultPlaceholder is the text for DRCS characters which are not decodable.
+const DefaultPlaceholder = "〓"
+
+var errShortDRCS = errors.New("arib: DRCS data is too short")
+
+// Glyph is a pattern of a DRCS character.
+type Glyph struct {
+	Width  int
+	Height int
+
+	// Depth is the number of bits per pixel.
+	Depth int
+
+	// Pixels is the gradation levels of pixels in row-major order.
+	Pixels []byte
+
+	pattern []byte
+	hash    string
+}
+
+// Hash returns the MD5 hash of the pattern data in hexadecimal, which
+// identifies the glyph regardless of its character code.
+func (g *Glyph) Hash() string {
+	return g.hash
+}
+
+// DRCSStore is a set of DRCS(Dynamically Redefinable Character Set)
+// characters loaded from DRCS data units.
+type DRCSStore struct {
+	glyphs       map[uint32]*Glyph // final byte << 16 | character code
+	replacements map[string]string // hash to text
+
+	// Placeholder is the text for characters which are not loaded or have no
+	// replacement.
+	Placeholder string
+}
+
+// NewDRCSStore returns an empty DRCSStore.
+func NewDRCSStore() *DRCSStore {
+	return &DRCSStore{
+		glyphs:       map[uint32]*Glyph{},
+		replacements: map[string]string{},
+		Placeholder:  DefaultPlaceholder,
+	}
+}
+
+// Register registers the text which replaces the glyph of the hash.
+func (s *DRCSStore) Register(hash, text string) {
+	s.replacements[hash] = text
+}
+
+// Glyph returns the glyph of the code in the DRCS designated by the final
+// byte.
+func (s *DRCSStore) Glyph(final, b1, b2 byte) (*Glyph, bool) {
+	g, ok := s.glyphs[drcsKey(final, b1, b2)]
+	return g, ok
+}
+
+// Set returns the graphic set of the DRCS designated by the final byte.
+func (s *DRCSStore) Set(final byte) GraphicSet {
+	return &drcsSet{s, final}
+}
+
+// placeholderSet is a DRCS whose glyphs are not loaded, which decodes all
+// characters to DefaultPlaceholder.
+type placeholderSet struct {
+	size int
+}
+
+func (s *placeholderSet) Get(_, _ byte) ([]byte, int) {
+	return []byte(DefaultPlaceholder), s.size
+}
+
+var singleBytePlaceholderSet = &placeholderSet{1}
+var doubleBytePlaceholderSet = &placeholderSet{2}
+
+func drcsKey(final, b1, b2 byte) uint32 {
+	if final == DRCS[0] {
+		return uint32(final)<<16 | uint32(b1)<<8 | uint32(b2)
+	}
+	return uint32(final)<<16 | uint32(b1)
+}
+
+// LoadDataUnit loads the DRCS data unit.
+//
+//	data_unit(){
+//	    unit_separator        8 uimsbf
+//	    data_unit_parameter   8 uimsbf
+//	    data_unit_size       24 uimsbf
+//	    for (i=0;i<N;i++){
+//	        data_unit_data_byte 8 bslbf
+//	    }
+//	}
+func (s *DRCSStore) LoadDataUnit(b []byte) error {
+	if len(b) < 5 {
+		return errShortDRCS
+	}
+	size := int(b[2])<<16 | int(b[3])<<8 | int(b[4])
+	if len(b) < 5+size {
+		return errShortDRCS
+	}
+	return s.Load(b[1], b[5:5+size])
+}
+
+// Load loads the data_unit_data_byte of the DRCS data unit with the
+// data_unit_parameter.
+//
+//	DRCS_data_structure(){
+//	    NumberOfCode            8 uimsbf
+//	    for (i=0;i<NumberOfCode;i++){
+//	        CharacterCode      16 uimsbf
+//	        NumberOfFont        8 uimsbf
+//	        for (j=0;j<NumberOfFont;j++){
+//	            fontId          4 uimsbf
+//	            mode            4 bslbf
+//	            if (mode==0000 || mode==0001){
+//	                depth       8 uimsbf
+//	                width       8 uimsbf
+//	                height      8 uimsbf
+//	                for (k=0;k<N;k++){
+//	                    patternData 8 bslbf
+//	                }
+//	            } else {
+//	                regionX     8 uimsbf
+//	                regionY     8 uimsbf
+//	                geometricData_length 16 uimsbf
+//	                for (k=0;k<N;k++){
+//	                    geometricData 8 bslbf
+//	                }
+//	            }
+//	        }
+//	    }
+//	}
+func (s *DRCSStore) Load(parameter byte, data []byte) error {
+	if parameter != DataUnitDRCS1 && parameter != DataUnitDRCS2 {
+		return fmt.Errorf("arib: 0x%02X is not a data unit parameter for DRCS", parameter)
+	}
+	if len(data) < 1 {
+		return errShortDRCS
+	}
+	n := int(data[0])
+	pos := 1
+	for i := 0; i < n; i++ {
+		if len(data) < pos+3 {
+			return errShortDRCS
+		}
+		var key uint32
+		if parameter == DataUnitDRCS1 {
+			// the final byte of DRCS and the code
+			key = drcsKey(data[pos], data[pos+1], 0)
+		} else {
+			key = drcsKey(DRCS[0], data[pos], data[pos+1])
+		}
+		fonts := int(data[pos+2])
+		pos += 3
+		for j := 0; j < fonts; j++ {
+			if len(data) < pos+4 {
+				return errShortDRCS
+			}
+			mode := data[pos] & 0x0F
+			if mode > 1 {
+				// geometric data is not supported
+				if len(data) < pos+5 {
+					return errShortDRCS
+				}
+				size := int(data[pos+3])<<8 | int(data[pos+4])
+				pos += 5 + size
+				continue
+			}
+			g, size, err := parseGlyph(data[pos:])
+			if err != nil {
+				return err
+			}
+			pos += size
+			if j == 0 {
+				// redefine by the first font
+				s.glyphs[key] = g
+			}
+		}
+	}
+	if pos > len(data) {
+		return errShortDRCS
+	}
+	return nil
+}
+
+// parseGlyph parses the pattern data from fontId and reports its size.
+func parseGlyph(b []byte) (*Glyph, int, error) {
+	levels := int(b[1]) + 2 // depth is the number of gradation levels minus 2
+	depth := 1
+	for 1<<uint(depth) < levels {
+		depth++
+	}
+	if depth > 8 {
+		return nil, 0, fmt.Errorf("arib: DRCS has unsupported depth %d", b[1])
+	}
+	g := &Glyph{
+		Width:  int(b[2]),
+		Height: int(b[3]),
+		Depth:  depth,
+	}
+	size := (g.Width*g.Height*depth + 7) / 8
+	if len(b) < 4+size {
+		return nil, 0, errShortDRCS
+	}
+	g.pattern = append([]byte(nil), b[4:4+size]...)
+	sum := md5.Sum(g.pattern)
+	g.hash = hex.EncodeToString(sum[:])
+
+	g.Pixels = make([]byte, g.Width*g.Height)
+	bit := 0
+	for i := range g.Pixels {
+		var p byte
+		for k := 0; k < depth; k++ {
+			p = p<<1 | g.pattern[bit/8]>>uint(7-bit%8)&1
+			bit++
+		}
+		g.Pixels[i] = p
+	}
+	return g, 4 + size, nil
+}
+
+type drcsSet struct {
+	store *DRCSStore
+	final byte
+}
+
+func (s *drcsSet) Get(b1, b2 byte) ([]byte, int) {
+	size := 1
+	if s.final == DRCS[0] {
+		size = 2
+	}
+	g, ok := s.store.Glyph(s.final, b1, b2)
+	if !ok {
+		return []byte(s.store.Placeholder), size
+	}
+	if text, ok := s.store.replacements[g.hash]; ok {
+		return []byte(text), size
+	}
+	return []byte(s.store.Placeholder), size
+}
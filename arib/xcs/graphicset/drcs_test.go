@@ -0,0 +1,142 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+)
+
+var (
+	_ GraphicSet = (*drcsSet)(nil)
+	_ GraphicSet = singleBytePlaceholderSet
+	_ GraphicSet = doubleBytePlaceholderSet
+)
+
+func md5hex(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDRCSStoreLoad(t *testing.T) {
+	s := NewDRCSStore()
+	// 1 byte DRCS, 2 levels
+	err := s.LoadDataUnit([]byte{
+		0x1F, DataUnitDRCS1, 0x00, 0x00, 0x0A,
+		0x01,       // NumberOfCode
+		0x41, 0x21, // CharacterCode
+		0x01,       // NumberOfFont
+		0x00,       // fontId, mode
+		0x00,       // depth
+		0x08, 0x02, // width, height
+		0xF0, 0x0F, // patternData
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2 byte DRCS, 4 levels
+	err = s.Load(DataUnitDRCS2, []byte{
+		0x01,       // NumberOfCode
+		0x21, 0x22, // CharacterCode
+		0x01,       // NumberOfFont
+		0x01,       // fontId, mode
+		0x02,       // depth
+		0x04, 0x01, // width, height
+		0x1B, // patternData
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, tc := range []struct {
+		final  byte
+		b1, b2 byte
+		ok     bool
+		width  int
+		height int
+		depth  int
+		pixels []byte
+		hash   string
+	}{
+		{0x41, 0x21, 0x00, true, 8, 2, 1, []byte{1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1}, md5hex([]byte{0xF0, 0x0F})},
+		{0x40, 0x21, 0x22, true, 4, 1, 2, []byte{0, 1, 2, 3}, md5hex([]byte{0x1B})},
+		{0x41, 0x22, 0x00, false, 0, 0, 0, nil, ""},
+		{0x42, 0x21, 0x00, false, 0, 0, 0, nil, ""},
+	} {
+		g, ok := s.Glyph(tc.final, tc.b1, tc.b2)
+		if ok != tc.ok {
+			t.Errorf("%d: Glyph(0x%02X, 0x%02X, 0x%02X) => _, %v, want _, %v", i, tc.final, tc.b1, tc.b2, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if g.Width != tc.width || g.Height != tc.height || g.Depth != tc.depth || !bytes.Equal(g.Pixels, tc.pixels) || g.Hash() != tc.hash {
+			t.Errorf("%d: Glyph(0x%02X, 0x%02X, 0x%02X) => %d, %d, %d, %v, %s, want %d, %d, %d, %v, %s", i, tc.final, tc.b1, tc.b2,
+				g.Width, g.Height, g.Depth, g.Pixels, g.Hash(), tc.width, tc.height, tc.depth, tc.pixels, tc.hash)
+		}
+	}
+}
+
+func TestDRCSStoreLoadError(t *testing.T) {
+	for i, tc := range []struct {
+		parameter byte
+		data      []byte
+		err       string
+	}{
+		{0x20, []byte{0x00}, "arib: 0x20 is not a data unit parameter for DRCS"},
+		{DataUnitDRCS1, []byte{}, "arib: DRCS data is too short"},
+		{DataUnitDRCS1, []byte{0x01, 0x41, 0x21}, "arib: DRCS data is too short"},
+		{DataUnitDRCS1, []byte{0x01, 0x41, 0x21, 0x01, 0x00, 0x00, 0x08, 0x02, 0xF0}, "arib: DRCS data is too short"},
+	} {
+		err := NewDRCSStore().Load(tc.parameter, tc.data)
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("%d: Load(0x%02X, 0x%X) => %v, want %s", i, tc.parameter, tc.data, err, tc.err)
+		}
+	}
+}
+
+func TestDRCSSetGet(t *testing.T) {
+	s := NewDRCSStore()
+	err := s.Load(DataUnitDRCS1, []byte{0x02,
+		0x41, 0x21, 0x01, 0x00, 0x00, 0x08, 0x01, 0xAA,
+		0x41, 0x22, 0x01, 0x00, 0x00, 0x08, 0x01, 0x55,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Register(md5hex([]byte{0xAA}), "♪")
+
+	for i, tc := range []struct {
+		placeholder string
+		b1          byte
+		buf         []byte
+		size        int
+	}{
+		{DefaultPlaceholder, 0x21, []byte("♪"), 1},
+		{DefaultPlaceholder, 0x22, []byte("〓"), 1},
+		{DefaultPlaceholder, 0x23, []byte("〓"), 1},
+		{"", 0x22, []byte{}, 1},
+		{"?", 0x23, []byte("?"), 1},
+	} {
+		s.Placeholder = tc.placeholder
+		buf, size := s.Set(0x41).Get(tc.b1, 0x00)
+		if !bytes.Equal(buf, tc.buf) || size != tc.size {
+			t.Errorf("%d: Get(0x%02X, 0x00) => %s, %d, want %s, %d", i, tc.b1, buf, size, tc.buf, tc.size)
+		}
+	}
+}
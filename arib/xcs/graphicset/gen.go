@@ -0,0 +1,193 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+//go:build ignore
+// +build ignore
+
+// This program generates jisx0208.go and jisx0213.go, the tables of JIS X
+// 0208 and JIS X 0213 characters. Invoke it as
+//
+//	go run gen.go -x0213 jisx0213-2004-std.txt
+//
+// where the mapping table of JIS X 0213 is in the format of
+// http://x0213.org/codetable/jisx0213-2004-std.txt.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+var x0213 = flag.String("x0213", "http://x0213.org/codetable/jisx0213-2004-std.txt", "path or URL of the mapping table of JIS X 0213")
+
+// decode decodes the code of JIS X 0208 by ISO-2022-JP.
+func decode(b1, b2 byte) string {
+	code := []byte{0x1B, 0x24, 0x40, b1, b2, 0x1B, 0x28, 0x4A}
+	tr := transform.NewReader(bytes.NewReader(code), japanese.ISO2022JP.NewDecoder())
+	buf, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return ""
+	}
+	return string(buf)
+}
+
+// readX0213 reads the mapping table of JIS X 0213 and returns the characters
+// of plane 1 and plane 2 by the codes.
+func readX0213(path string) (map[int]string, map[int]string, error) {
+	var r io.Reader
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		resp, err := http.Get(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer resp.Body.Close()
+		r = resp.Body
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	planes := [2]map[int]string{{}, {}}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		// 3-2477	U+304B+309A	# ...
+		fields := strings.Split(s.Text(), "\t")
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || !strings.HasPrefix(fields[1], "U+") {
+			continue
+		}
+		if len(fields[0]) != 6 || (fields[0][0] != '3' && fields[0][0] != '4') {
+			return nil, nil, fmt.Errorf("invalid code %q", fields[0])
+		}
+		code, err := strconv.ParseUint(fields[0][2:], 16, 16)
+		if err != nil {
+			return nil, nil, err
+		}
+		var text []rune
+		for _, u := range strings.Split(fields[1][2:], "+") {
+			r, err := strconv.ParseUint(u, 16, 32)
+			if err != nil {
+				return nil, nil, err
+			}
+			text = append(text, rune(r))
+		}
+		planes[fields[0][0]-'3'][int(code)] = string(text)
+	}
+	return planes[0], planes[1], s.Err()
+}
+
+// table is a table of characters in order of the codes.
+type table struct {
+	rows  []string
+	index []int
+}
+
+// newTable returns the table of the characters by fn for the codes.
+func newTable(fn func(b1, b2 byte) string) *table {
+	t := &table{index: []int{0}}
+	n := 0
+	for b1 := byte(0x21); b1 <= 0x7E; b1++ {
+		var row bytes.Buffer
+		for b2 := byte(0x21); b2 <= 0x7E; b2++ {
+			row.WriteString(fn(b1, b2))
+			t.index = append(t.index, n+row.Len())
+		}
+		t.rows = append(t.rows, row.String())
+		n += row.Len()
+	}
+	return t
+}
+
+// write writes the table as the constant of text and the variable of index.
+func (t *table) write(w io.Writer, name, desc string) {
+	fmt.Fprintf(w, "// %sText is the characters of %s in order of the codes.\n", name, desc)
+	fmt.Fprintf(w, "const %sText = \"\" +\n", name)
+	for i, row := range t.rows {
+		fmt.Fprintf(w, "\t%q", row)
+		if i < len(t.rows)-1 {
+			fmt.Fprint(w, " +")
+		}
+		fmt.Fprintf(w, " // row %d\n", i+1)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "// %sIndex is the offsets of the characters in %sText.\n", name, name)
+	fmt.Fprintf(w, "var %sIndex = [%d]uint16{", name, len(t.index))
+	for i, n := range t.index {
+		if i%16 == 0 {
+			fmt.Fprint(w, "\n")
+		}
+		fmt.Fprintf(w, "%d, ", n)
+	}
+	fmt.Fprintln(w, "\n}")
+	fmt.Fprintln(w)
+}
+
+// writeFile writes the tables to the file.
+func writeFile(filename string, fn func(w io.Writer)) {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen.go. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package graphicset")
+	fmt.Fprintln(&buf)
+	fn(&buf)
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	writeFile("jisx0208.go", func(w io.Writer) {
+		newTable(decode).write(w, "jisX0208", "JIS X 0208")
+	})
+
+	plane1, plane2, err := readX0213(*x0213)
+	if err != nil {
+		log.Fatal(err)
+	}
+	lookup := func(plane map[int]string) func(b1, b2 byte) string {
+		return func(b1, b2 byte) string {
+			if s, ok := plane[int(b1)<<8|int(b2)]; ok {
+				return s
+			}
+			return "\uFFFD"
+		}
+	}
+	writeFile("jisx0213.go", func(w io.Writer) {
+		newTable(lookup(plane1)).write(w, "jisX0213Plane1", "JIS X 0213 plane 1")
+		newTable(lookup(plane2)).write(w, "jisX0213Plane2", "JIS X 0213 plane 2")
+	})
+}
@@ -0,0 +1,44 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"golang.org/x/text/encoding/charmap"
+)
+
+// LatinExtension is a final byte of the Latin extension graphic set of
+// ISDB-Tb (ABNT NBR 15603-2), which is the upper half of ISO/IEC 8859-15.
+const LatinExtension = 0x4B
+
+// LatinSetMap maps a final byte to a G set of the Latin character set of
+// ISDB-Tb.
+var LatinSetMap = map[byte]GraphicSet{
+	Alphanumeric:   latinAlphanumericSet,
+	LatinExtension: latinExtensionSet,
+}
+
+// latinAlphanumericSet is the lower half of ISO/IEC 8859-15, i.e. ASCII.
+var latinAlphanumericSet = newLatinSet(0x00)
+
+// latinExtensionSet is the upper half of ISO/IEC 8859-15.
+var latinExtensionSet = newLatinSet(0x80)
+
+func newLatinSet(half byte) *singleByteGraphicMap {
+	m := map[byte]string{}
+	for b := byte(0x21); b <= 0x7E; b++ {
+		m[b] = string(charmap.ISO8859_15.DecodeByte(b | half))
+	}
+	return &singleByteGraphicMap{m}
+}
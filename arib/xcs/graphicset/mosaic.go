@@ -0,0 +1,104 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+// Mosaic is a pattern of the 2x3 cells of a mosaic character. Bits 0 to 5 are
+// the cells from the upper left to the lower right in row-major order.
+//
+//	+---+---+
+//	| 0 | 1 |
+//	+---+---+
+//	| 2 | 3 |
+//	+---+---+
+//	| 4 | 5 |
+//	+---+---+
+type Mosaic byte
+
+// MosaicFull is the pattern whose cells are all filled.
+const MosaicFull Mosaic = 0x3F
+
+// Cell reports whether the cell at the row (0 to 2) and the column (0 or 1) is
+// filled.
+func (m Mosaic) Cell(row, col int) bool {
+	return m>>uint(row*2+col)&1 == 1
+}
+
+// String returns the character of the pattern in Symbols for Legacy
+// Computing, or a block element for the patterns which are not in it.
+func (m Mosaic) String() string {
+	m &= MosaicFull
+	switch m {
+	case 0:
+		return " "
+	case 0x15:
+		return "▌" // left half
+	case 0x2A:
+		return "▐" // right half
+	case MosaicFull:
+		return "█"
+	}
+	// sextants are in the order of patterns except the block elements
+	r := 0x1FB00 + rune(m) - 1
+	if m > 0x15 {
+		r--
+	}
+	if m > 0x2A {
+		r--
+	}
+	return string(r)
+}
+
+// MosaicSet is a graphic set of mosaic characters.
+//
+// The codes in columns 2, 3, 6 and 7 are the 2x3 block patterns whose cells
+// are bits 1 to 5 and 7 of the code as in teletext. The other codes are not
+// defined.
+type MosaicSet struct {
+	// NonSpacing reports whether the characters are non-spacing, which are
+	// overlaid on the following character.
+	NonSpacing bool
+}
+
+var (
+	mosaicASet = &MosaicSet{}
+	mosaicBSet = &MosaicSet{}
+	mosaicCSet = &MosaicSet{NonSpacing: true}
+	mosaicDSet = &MosaicSet{NonSpacing: true}
+)
+
+// Mosaic returns the pattern of the code.
+func (s *MosaicSet) Mosaic(b byte) (Mosaic, bool) {
+	switch {
+	case b >= 0x20 && b <= 0x3F:
+		return Mosaic(b & 0x1F), true
+	case b >= 0x60 && b <= 0x7F:
+		return Mosaic(b&0x1F | 0x20), true
+	}
+	return 0, false
+}
+
+// Get returns the character of the pattern of the code.
+func (s *MosaicSet) Get(b1, _ byte) ([]byte, int) {
+	m, ok := s.Mosaic(b1)
+	if !ok {
+		return nil, 1
+	}
+	return []byte(m.String()), 1
+}
+
+func (s *MosaicSet) defined(b1, _ byte) bool {
+	_, ok := s.Mosaic(b1)
+	return ok
+}
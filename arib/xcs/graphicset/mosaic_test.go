@@ -0,0 +1,85 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMosaicString(t *testing.T) {
+	for i, tc := range []struct {
+		m Mosaic
+		s string
+	}{
+		{0x00, " "},
+		{0x01, "\U0001FB00"},
+		{0x14, "\U0001FB13"},
+		{0x15, "▌"},
+		{0x16, "\U0001FB14"},
+		{0x29, "\U0001FB27"},
+		{0x2A, "▐"},
+		{0x2B, "\U0001FB28"},
+		{0x3E, "\U0001FB3B"},
+		{0x3F, "█"},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			if s := tc.m.String(); s != tc.s {
+				t.Errorf("%d: Mosaic(0x%02X).String() => %q, want %q", i, byte(tc.m), s, tc.s)
+			}
+		})
+	}
+}
+
+func TestMosaicCell(t *testing.T) {
+	m := Mosaic(0x19) // upper left, middle right, lower left
+	want := [3][2]bool{{true, false}, {false, true}, {true, false}}
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 2; col++ {
+			if got := m.Cell(row, col); got != want[row][col] {
+				t.Errorf("Mosaic(0x19).Cell(%d, %d) => %v, want %v", row, col, got, want[row][col])
+			}
+		}
+	}
+}
+
+func TestMosaicSetGet(t *testing.T) {
+	for i, tc := range []struct {
+		b1   byte
+		buf  []byte
+		size int
+	}{
+		{0x21, []byte("\U0001FB00"), 1},
+		{0x3F, []byte("\U0001FB1D"), 1},
+		{0x40, nil, 1},
+		{0x5F, nil, 1},
+		{0x60, []byte("\U0001FB1E"), 1},
+		{0x35, []byte("▌"), 1},
+		{0x7E, []byte("\U0001FB3B"), 1},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			buf, size := GSetMap[MosaicA].Get(tc.b1, 0x00)
+			if !bytes.Equal(buf, tc.buf) || size != tc.size {
+				t.Errorf("%d: Get(0x%02X, 0x00) => %s, %d, want %s, %d", i, tc.b1, buf, size, tc.buf, tc.size)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Profile is a mapping of the additional symbols to Unicode.
+type Profile struct {
+	// Name is the name of the profile.
+	Name string
+
+	symbols *additionalSymbolMap
+	kanji   *kanjiTable
+}
+
+func newProfile(name string, symbols *additionalSymbolMap) *Profile {
+	return &Profile{
+		Name:    name,
+		symbols: symbols,
+		kanji: &kanjiTable{
+			data:    kanjiSet.data,
+			index:   kanjiSet.index,
+			symbols: symbols,
+		},
+	}
+}
+
+// GraphicSet returns the graphic set of the final byte with the profile, or
+// nil if the final byte is unknown.
+func (p *Profile) GraphicSet(final byte) GraphicSet {
+	switch final {
+	case Symbols:
+		return p.symbols
+	case Kanji:
+		return p.kanji
+	}
+	return GSetMap[final]
+}
+
+// Profiles of the additional symbols
+var (
+	// ProfileDefault maps the symbols to the text in the tables, e.g. "【字】"
+	// and "㍻".
+	ProfileDefault = &Profile{Name: "default", symbols: additionalSymbolSet, kanji: kanjiSet}
+
+	// ProfileUnicode maps the symbols to the enclosed characters of Unicode
+	// 5.2, e.g. "🈑" and "🈔", if they have ones.
+	ProfileUnicode = newProfile("unicode", overrideSymbols(unicodeSymbols))
+
+	// ProfileBracket maps the symbols to the text with ASCII brackets and
+	// letters, e.g. "[字]" and "平成".
+	ProfileBracket = newProfile("bracket", mapSymbols(bracketText))
+
+	// ProfilePrivateUse maps the symbols in rows 90 to 94 to the private use
+	// code points in order of the codes, where 90-48 is U+E0F8, as the
+	// legacy fonts for ARIB.
+	ProfilePrivateUse = newProfile("private-use", privateUseSymbols())
+)
+
+// Profiles maps a name to a profile.
+var Profiles = map[string]*Profile{
+	ProfileDefault.Name:    ProfileDefault,
+	ProfileUnicode.Name:    ProfileUnicode,
+	ProfileBracket.Name:    ProfileBracket,
+	ProfilePrivateUse.Name: ProfilePrivateUse,
+}
+
+// unicodeSymbols is the enclosed characters of Unicode 5.2 for the symbols.
+var unicodeSymbols = map[uint16]string{
+	0x7A50: "🅊", 0x7A51: "🅌", 0x7A52: "🄿", 0x7A53: "🅆", 0x7A54: "🅋",
+	0x7A55: "🈐", 0x7A56: "🈑", 0x7A57: "🈒", 0x7A58: "🈓", 0x7A59: "🅂",
+	0x7A5A: "🈔", 0x7A5B: "🈕", 0x7A5C: "🈖", 0x7A5D: "🅍", 0x7A5E: "🄱",
+	0x7A5F: "🄽", 0x7A60: "⬛", 0x7A61: "⬤", 0x7A62: "🈗", 0x7A63: "🈘",
+	0x7A64: "🈙", 0x7A65: "🈚", 0x7A66: "🈛", 0x7A67: "⚿", 0x7A68: "🈜",
+	0x7A69: "🈝", 0x7A6A: "🈞", 0x7A6B: "🈟", 0x7A6C: "🈠", 0x7A6D: "🈡",
+	0x7A6E: "🈢", 0x7A6F: "🈣", 0x7A70: "🈤", 0x7A71: "🈥", 0x7A72: "🅎",
+	0x7A73: "㊙", 0x7A74: "🈀",
+
+	0x7C30: "🄀", 0x7C31: "⒈", 0x7C32: "⒉", 0x7C33: "⒊", 0x7C34: "⒋",
+	0x7C35: "⒌", 0x7C36: "⒍", 0x7C37: "⒎", 0x7C38: "⒏", 0x7C39: "⒐",
+	0x7C40: "🄁", 0x7C41: "🄂", 0x7C42: "🄃", 0x7C43: "🄄", 0x7C44: "🄅",
+	0x7C45: "🄆", 0x7C46: "🄇", 0x7C47: "🄈", 0x7C48: "🄉", 0x7C49: "🄊",
+	0x7C4A: "㈳", 0x7C4B: "㈶", 0x7C4C: "㈲", 0x7C4D: "㈱", 0x7C4E: "㈹",
+	0x7C4F: "㉄", 0x7C55: "²", 0x7C56: "³", 0x7C57: "🄭", 0x7C76: "🄬",
+	0x7C77: "🄫", 0x7C79: "🆐", 0x7C7A: "🈦", 0x7C7B: "℻",
+
+	0x7D31: "🉀", 0x7D32: "🉁", 0x7D33: "🉂", 0x7D34: "🉃", 0x7D35: "🉄",
+	0x7D36: "🉅", 0x7D37: "🉆", 0x7D38: "🉇", 0x7D39: "🉈", 0x7D3A: "🄪",
+	0x7D3B: "🈧", 0x7D3C: "🈨", 0x7D3D: "🈩", 0x7D3E: "🈔", 0x7D3F: "🈪",
+	0x7D40: "🈫", 0x7D41: "🈬", 0x7D42: "🈭", 0x7D43: "🈮", 0x7D44: "🈯",
+	0x7D45: "🈰", 0x7D46: "🈱", 0x7D50: "½", 0x7D51: "↉", 0x7D52: "⅓",
+	0x7D53: "⅔", 0x7D54: "¼", 0x7D55: "¾", 0x7D56: "⅕", 0x7D57: "⅖",
+	0x7D58: "⅗", 0x7D59: "⅘", 0x7D5A: "⅙", 0x7D5B: "⅚", 0x7D5C: "⅐",
+	0x7D5D: "⅛", 0x7D5E: "⅑", 0x7D5F: "⅒", 0x7D63: "⛄", 0x7D66: "⛉",
+	0x7D67: "⛊", 0x7D70: "⛅", 0x7D72: "⛆", 0x7D73: "☃", 0x7D74: "⛇",
+	0x7D76: "⛈",
+
+	0x7E41: "🄐", 0x7E42: "🄑", 0x7E43: "🄒", 0x7E44: "🄓", 0x7E45: "🄔",
+	0x7E46: "🄕", 0x7E47: "🄖", 0x7E48: "🄗", 0x7E49: "🄘", 0x7E4A: "🄙",
+	0x7E4B: "🄚", 0x7E4C: "🄛", 0x7E4D: "🄜", 0x7E4E: "🄝", 0x7E4F: "🄞",
+	0x7E50: "🄟", 0x7E51: "🄠", 0x7E52: "🄡", 0x7E53: "🄢", 0x7E54: "🄣",
+	0x7E55: "🄤", 0x7E56: "🄥", 0x7E57: "🄦", 0x7E58: "🄧", 0x7E59: "🄨",
+	0x7E5A: "🄩",
+}
+
+// overrideSymbols returns the additional symbols overridden by m.
+func overrideSymbols(m map[uint16]string) *additionalSymbolMap {
+	symbols := mapSymbols(func(s string) string { return s })
+	for c, s := range m {
+		symbols.m[c] = s
+	}
+	return symbols
+}
+
+// mapSymbols returns the additional symbols mapped by fn.
+func mapSymbols(fn func(s string) string) *additionalSymbolMap {
+	m := make(map[uint16]string, len(additionalSymbolSet.m))
+	for c, s := range additionalSymbolSet.m {
+		m[c] = fn(s)
+	}
+	return &additionalSymbolMap{m}
+}
+
+// privateUseSymbols returns the additional symbols whose codes in rows 90 to
+// 94 are mapped to the private use area.
+func privateUseSymbols() *additionalSymbolMap {
+	symbols := mapSymbols(func(s string) string { return s })
+	for c := range symbols.m {
+		row, cell := int(c>>8)-0x20, int(c&0xFF)-0x20
+		if row < 90 {
+			continue
+		}
+		symbols.m[c] = string(rune(0xE0F8 + (row-90)*94 + cell - 48))
+	}
+	return symbols
+}
+
+var bracketReplacer = strings.NewReplacer(
+	"【", "[", "】", "]",
+	"〔", "[", "〕", "]",
+	"［", "[", "］", "]",
+	"〖", "[", "〗", "]",
+	"（", "(", "）", ")",
+)
+
+// bracketText returns s with ASCII brackets and letters.
+func bracketText(s string) string {
+	s = bracketReplacer.Replace(s)
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '！' && r <= '～':
+			b.WriteRune(r - '！' + '!')
+		case r >= '❶' && r <= '❿':
+			b.WriteString("(" + strconv.Itoa(int(r-'❶'+1)) + ")")
+		case r >= '⓫' && r <= '⓴':
+			b.WriteString("(" + strconv.Itoa(int(r-'⓫'+11)) + ")")
+		case r >= '①' && r <= '⑳', r >= '㉑' && r <= '㉟':
+			b.WriteString("(" + norm.NFKC.String(string(r)) + ")")
+		case r >= '℀' && r <= '↏', r >= '⑴' && r <= '⒛', r >= '㈀' && r <= '㏿':
+			b.WriteString(norm.NFKC.String(string(r)))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"testing"
+)
+
+func TestProfileGraphicSet(t *testing.T) {
+	for i, tc := range []struct {
+		name  string
+		final byte
+		b1    byte
+		b2    byte
+		s     string
+	}{
+		{"default", Symbols, 0x7A, 0x56, "【字】"},
+		{"default", Kanji, 0x7D, 0x2C, "㍻"},
+		{"unicode", Symbols, 0x7A, 0x56, "🈑"},
+		{"unicode", Kanji, 0x7A, 0x5A, "🈔"},
+		{"unicode", Kanji, 0x7D, 0x2C, "㍻"},
+		{"unicode", Kanji, 0x30, 0x21, "亜"},
+		{"bracket", Symbols, 0x7A, 0x56, "[字]"},
+		{"bracket", Kanji, 0x7A, 0x52, "[P]"},
+		{"bracket", Kanji, 0x7D, 0x2C, "平成"},
+		{"bracket", Kanji, 0x7D, 0x21, "(月)"},
+		{"bracket", Kanji, 0x7E, 0x41, "(A)"},
+		{"bracket", Kanji, 0x7E, 0x61, "(1)"},
+		{"bracket", Kanji, 0x7E, 0x71, "(1)"},
+		{"bracket", Kanji, 0x75, 0x21, "㐂"},
+		{"private-use", Symbols, 0x7A, 0x50, ""},
+		{"private-use", Kanji, 0x7B, 0x21, ""},
+		{"private-use", Kanji, 0x75, 0x21, "㐂"},
+		{"private-use", Alphanumeric, 0x41, 0, "Ａ"},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			p, ok := Profiles[tc.name]
+			if !ok {
+				t.Fatalf("%d: Profiles[%q] is not found", i, tc.name)
+			}
+			gs := p.GraphicSet(tc.final)
+			got, _ := gs.Get(tc.b1, tc.b2)
+			if string(got) != tc.s {
+				t.Errorf("%d: %s.GraphicSet(0x%X).Get(0x%X, 0x%X) => %s, want %s", i, tc.name, tc.final, tc.b1, tc.b2, got, tc.s)
+			}
+		})
+	}
+}
+
+func TestProfileDefined(t *testing.T) {
+	for _, p := range Profiles {
+		for c, s := range additionalSymbolSet.m {
+			b1, b2 := byte(c>>8), byte(c)
+			got, _ := p.GraphicSet(Symbols).Get(b1, b2)
+			if len(got) == 0 {
+				t.Errorf("%s.GraphicSet(Symbols).Get(0x%X, 0x%X) => empty, want text of %s", p.Name, b1, b2, s)
+			}
+		}
+		if Defined(p.GraphicSet(Kanji), 0x7D, 0x4E) {
+			t.Errorf("Defined(%s.GraphicSet(Kanji), 0x7D, 0x4E) => true, want false", p.Name)
+		}
+	}
+}
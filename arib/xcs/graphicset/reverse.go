@@ -0,0 +1,185 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"sort"
+	"sync"
+)
+
+// Code is a character code in a graphic set.
+type Code struct {
+	// Final is the final byte of the graphic set.
+	Final byte
+
+	// B1 and B2 are the bytes of the code. B2 is 0 for 1 byte graphic sets.
+	B1, B2 byte
+
+	// Size is the number of bytes of the code.
+	Size int
+}
+
+// IsDoubleByte reports whether the graphic set designated by the final byte
+// is a 2 byte graphic set.
+func IsDoubleByte(final byte) bool {
+	switch final {
+	case JISKanji1, JISKanji2, Symbols, Kanji:
+		return true
+	}
+	return false
+}
+
+// encodableSets is the final bytes of graphic sets which are used to encode,
+// in order of preference.
+var encodableSets = []byte{
+	Hiragana,
+	Katakana,
+	Alphanumeric,
+	Kanji,
+	Symbols,
+	JISX0201Katakana,
+}
+
+// fallbackSets is the final bytes of graphic sets which are used to encode the
+// text which encodableSets do not have.
+var fallbackSets = []byte{
+	JISKanji1,
+	JISKanji2,
+}
+
+// enumerator is the interface for graphic sets whose codes can be enumerated.
+type enumerator interface {
+	each(fn func(b1, b2 byte, s string))
+}
+
+func (m *singleByteGraphicMap) each(fn func(b1, b2 byte, s string)) {
+	for b1, s := range m.m {
+		fn(b1, 0, s)
+	}
+}
+
+func (m *doubleByteGraphicMap) each(fn func(b1, b2 byte, s string)) {
+	for c, s := range m.m {
+		fn(byte(c>>8), byte(c), s)
+	}
+}
+
+func (m *additionalSymbolMap) each(fn func(b1, b2 byte, s string)) {
+	for c, s := range m.m {
+		fn(byte(c>>8), byte(c), s)
+	}
+}
+
+func (t *kanjiTable) each(fn func(b1, b2 byte, s string)) {
+	for b1 := byte(0x21); b1 <= 0x7E; b1++ {
+		if t == kanjiSet && (b1 >= 0x29 && b1 <= 0x2F || b1 > 0x74) {
+			// rows 9 to 15 and after 84 are not assigned in JIS X 0208
+			continue
+		}
+		for b2 := byte(0x21); b2 <= 0x7E; b2++ {
+			buf, _ := t.Get(b1, b2)
+			fn(b1, b2, string(buf))
+		}
+	}
+}
+
+var reverse struct {
+	once   sync.Once
+	m      map[string][]Code
+	maxLen int
+}
+
+func buildReverse() {
+	m := map[string][]Code{}
+	maxLen := 0
+	sets := append(append([]byte(nil), encodableSets...), fallbackSets...)
+	for i, final := range sets {
+		fallback := i >= len(encodableSets)
+		e, ok := GSetMap[final].(enumerator)
+		if !ok {
+			continue
+		}
+		size := 1
+		if IsDoubleByte(final) {
+			size = 2
+		}
+		type entry struct {
+			code Code
+			s    string
+		}
+		var entries []entry
+		e.each(func(b1, b2 byte, s string) {
+			if s == "" || s == "\uFFFD" {
+				return
+			}
+			entries = append(entries, entry{Code{Final: final, B1: b1, B2: b2, Size: size}, s})
+		})
+		sort.Slice(entries, func(i, j int) bool {
+			a, b := entries[i].code, entries[j].code
+			if a.B1 != b.B1 {
+				return a.B1 < b.B1
+			}
+			return a.B2 < b.B2
+		})
+		for _, en := range entries {
+			if containsFinal(m[en.s], final) {
+				// keep the smallest code of each graphic set
+				continue
+			}
+			if fallback && len(m[en.s]) > 0 {
+				continue
+			}
+			m[en.s] = append(m[en.s], en.code)
+			if len(en.s) > maxLen {
+				maxLen = len(en.s)
+			}
+		}
+	}
+	reverse.m = m
+	reverse.maxLen = maxLen
+}
+
+func containsFinal(codes []Code, final byte) bool {
+	for _, c := range codes {
+		if c.Final == final {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxMatchLen returns the maximum length in bytes of the text which Match can
+// match.
+func MaxMatchLen() int {
+	reverse.once.Do(buildReverse)
+	return reverse.maxLen
+}
+
+// Match reports the length in bytes of the longest prefix of s which a code
+// represents, and the codes for the prefix in order of preference.
+// It returns 0 and nil if no code represents any prefix of s.
+func Match(s string) (int, []Code) {
+	reverse.once.Do(buildReverse)
+	n := len(s)
+	if n > reverse.maxLen {
+		n = reverse.maxLen
+	}
+	for ; n > 0; n-- {
+		if codes, ok := reverse.m[s[:n]]; ok && len(codes) > 0 {
+			return n, codes
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,47 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package graphicset
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	for i, tc := range []struct {
+		s     string
+		n     int
+		codes []Code
+	}{
+		{"あい", 3, []Code{{Hiragana, 0x22, 0, 1}, {Kanji, 0x24, 0x22, 2}}},
+		{"Ｅテレ", 3, []Code{{Alphanumeric, 0x45, 0, 1}, {Kanji, 0x23, 0x45, 2}}},
+		{"【字】です", 9, []Code{{Symbols, 0x7A, 0x56, 2}}},
+		{"楽", 3, []Code{{Kanji, 0x33, 0x5A, 2}}},
+		{"㍻", 3, []Code{{Symbols, 0x7D, 0x2C, 2}}},
+		{"か\u309Aき", 6, []Code{{JISKanji1, 0x24, 0x77, 2}}},
+		{"\U00020089", 4, []Code{{JISKanji2, 0x21, 0x21, 2}}},
+		{"\x00", 0, nil},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			n, codes := Match(tc.s)
+			if n != tc.n || !reflect.DeepEqual(codes, tc.codes) {
+				t.Errorf("%d: Match(%s) => %d, %v, want %d, %v", i, tc.s, n, codes, tc.n, tc.codes)
+			}
+		})
+	}
+}
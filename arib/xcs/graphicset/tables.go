@@ -15,12 +15,7 @@
 package graphicset
 
 import (
-	"bytes"
 	"encoding/binary"
-	"io/ioutil"
-
-	"golang.org/x/text/encoding/japanese"
-	"golang.org/x/text/transform"
 )
 
 const (
@@ -88,15 +83,15 @@ type GraphicSet interface {
 var GSetMap = map[byte]GraphicSet{
 	Hiragana:         hiraganaSet,
 	Katakana:         katakanaSet,
-	MosaicA:          singleByteEmptySet, // not supported
-	MosaicB:          singleByteEmptySet, // not supported
-	MosaicC:          singleByteEmptySet, // TODO: non-spacing
-	MosaicD:          singleByteEmptySet, // TODO: non-spacing
+	MosaicA:          mosaicASet,
+	MosaicB:          mosaicBSet,
+	MosaicC:          mosaicCSet,
+	MosaicD:          mosaicDSet,
 	PropAlphanumeric: alphanumericSet,
 	PropHiragana:     hiraganaSet,
 	PropKatakana:     katakanaSet,
-	JISKanji1:        kanjiSet,
-	JISKanji2:        kanjiSet,
+	JISKanji1:        jisKanji1Set,
+	JISKanji2:        jisKanji2Set,
 	Symbols:          additionalSymbolSet,
 	Kanji:            kanjiSet,
 	Alphanumeric:     alphanumericSet,
@@ -105,23 +100,23 @@ var GSetMap = map[byte]GraphicSet{
 
 // DRCSMap maps a final byte to a DRCS.
 var DRCSMap = map[byte]GraphicSet{
-	// not supported
-	DRCS[0]:  doubleByteEmptySet,
-	DRCS[1]:  singleByteEmptySet,
-	DRCS[2]:  singleByteEmptySet,
-	DRCS[3]:  singleByteEmptySet,
-	DRCS[4]:  singleByteEmptySet,
-	DRCS[5]:  singleByteEmptySet,
-	DRCS[6]:  singleByteEmptySet,
-	DRCS[7]:  singleByteEmptySet,
-	DRCS[8]:  singleByteEmptySet,
-	DRCS[9]:  singleByteEmptySet,
-	DRCS[10]: singleByteEmptySet,
-	DRCS[11]: singleByteEmptySet,
-	DRCS[12]: singleByteEmptySet,
-	DRCS[13]: singleByteEmptySet,
-	DRCS[14]: singleByteEmptySet,
-	DRCS[15]: singleByteEmptySet,
+	// the glyphs are not loaded
+	DRCS[0]:  doubleBytePlaceholderSet,
+	DRCS[1]:  singleBytePlaceholderSet,
+	DRCS[2]:  singleBytePlaceholderSet,
+	DRCS[3]:  singleBytePlaceholderSet,
+	DRCS[4]:  singleBytePlaceholderSet,
+	DRCS[5]:  singleBytePlaceholderSet,
+	DRCS[6]:  singleBytePlaceholderSet,
+	DRCS[7]:  singleBytePlaceholderSet,
+	DRCS[8]:  singleBytePlaceholderSet,
+	DRCS[9]:  singleBytePlaceholderSet,
+	DRCS[10]: singleBytePlaceholderSet,
+	DRCS[11]: singleBytePlaceholderSet,
+	DRCS[12]: singleBytePlaceholderSet,
+	DRCS[13]: singleBytePlaceholderSet,
+	DRCS[14]: singleBytePlaceholderSet,
+	DRCS[15]: singleBytePlaceholderSet,
 	Macro:    singleByteEmptySet,
 }
 
@@ -131,47 +126,107 @@ type singleByteGraphicMap struct {
 
 func (m *singleByteGraphicMap) Get(b1, _ byte) ([]byte, int) {
 	if m == nil || m.m == nil {
-		return nil, 0
+		return nil, 1
 	}
 	return []byte(m.m[b1]), 1
 }
 
+func (m *singleByteGraphicMap) defined(b1, _ byte) bool {
+	_, ok := m.m[b1]
+	return ok
+}
+
 type doubleByteGraphicMap struct {
 	m map[uint16]string
 }
 
 func (m *doubleByteGraphicMap) Get(b1, b2 byte) ([]byte, int) {
 	if m == nil || m.m == nil {
-		return nil, 0
+		return nil, 2
 	}
 	return []byte(m.m[binary.BigEndian.Uint16([]byte{b1, b2})]), 2
 }
 
+func (m *doubleByteGraphicMap) defined(b1, b2 byte) bool {
+	_, ok := m.m[binary.BigEndian.Uint16([]byte{b1, b2})]
+	return ok
+}
+
+// additionalSymbolMap is a graphic set of the additional symbols and kanji of
+// ARIB in rows 85, 86 and 90 to 94.
 type additionalSymbolMap struct {
 	m map[uint16]string
 }
 
 func (m *additionalSymbolMap) Get(b1, b2 byte) ([]byte, int) {
 	if m == nil || m.m == nil {
-		return nil, 0
+		return nil, 2
 	}
-	buf := []byte(m.m[binary.BigEndian.Uint16([]byte{b1, b2})])
-	if bytes.Equal(buf, []byte{}) {
-		buf = []byte("・")
+	s, ok := m.m[binary.BigEndian.Uint16([]byte{b1, b2})]
+	if !ok {
+		return []byte("\uFFFD"), 2
 	}
-	return buf, 2
+	return []byte(s), 2
 }
 
-type kanjiGraphicConv struct{}
+func (m *additionalSymbolMap) defined(b1, b2 byte) bool {
+	_, ok := m.m[binary.BigEndian.Uint16([]byte{b1, b2})]
+	return ok
+}
+
+// definer is the interface for graphic sets which know their repertoires.
+type definer interface {
+	defined(b1, b2 byte) bool
+}
+
+// Defined reports whether the code is defined in the graphic set. Codes of
+// graphic sets which do not know their repertoires are always defined.
+func Defined(gs GraphicSet, b1, b2 byte) bool {
+	if d, ok := gs.(definer); ok {
+		return d.defined(b1, b2)
+	}
+	return true
+}
+
+//go:generate go run gen.go
+
+// kanjiTable is a graphic set of 94x94 kanji in the generated table.
+type kanjiTable struct {
+	data  []byte
+	index []uint16
 
-func (conv kanjiGraphicConv) Get(b1, b2 byte) ([]byte, int) {
-	code := []byte{0x1B, 0x24, 0x40, b1, b2, 0x1B, 0x28, 0x4A}
-	tr := transform.NewReader(bytes.NewReader(code), japanese.ISO2022JP.NewDecoder())
-	buf, err := ioutil.ReadAll(tr)
-	if err != nil {
+	// symbols is the additional symbols which replace rows 85 to 94 if it is
+	// not nil.
+	symbols *additionalSymbolMap
+}
+
+func newKanjiTable(text string, index []uint16) *kanjiTable {
+	return &kanjiTable{data: []byte(text), index: index}
+}
+
+func (t *kanjiTable) Get(b1, b2 byte) ([]byte, int) {
+	if b1 < 0x21 || b1 > 0x7E || b2 < 0x21 || b2 > 0x7E {
 		return nil, 2
 	}
-	return buf, 2
+	if t.isSymbol(b1) {
+		return t.symbols.Get(b1, b2)
+	}
+	i := int(b1-0x21)*94 + int(b2-0x21)
+	start, end := t.index[i], t.index[i+1]
+	return t.data[start:end:end], 2
+}
+
+func (t *kanjiTable) defined(b1, b2 byte) bool {
+	if t.isSymbol(b1) {
+		return t.symbols.defined(b1, b2)
+	}
+	buf, _ := t.Get(b1, b2)
+	return len(buf) > 0 && string(buf) != "\uFFFD"
+}
+
+// isSymbol reports whether the row of b1 is in the additional symbols.
+func (t *kanjiTable) isSymbol(b1 byte) bool {
+	return t.symbols != nil && b1 >= 0x75
 }
 
 var singleByteEmptySet = &singleByteGraphicMap{}
@@ -468,9 +523,55 @@ var alphanumericSet = &singleByteGraphicMap{map[byte]string{
 	0x7E: "￣",
 }}
 
-var kanjiSet = &kanjiGraphicConv{}
+// kanjiSet is the Kanji set of ARIB, which is JIS X 0208 with the additional
+// symbols.
+var kanjiSet = &kanjiTable{
+	data:    []byte(jisX0208Text),
+	index:   jisX0208Index[:],
+	symbols: additionalSymbolSet,
+}
+
+var jisKanji1Set = newKanjiTable(jisX0213Plane1Text, jisX0213Plane1Index[:])
+
+var jisKanji2Set = newKanjiTable(jisX0213Plane2Text, jisX0213Plane2Index[:])
 
 var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
+	0x7A21: "⛌",
+	0x7A22: "⛍",
+	0x7A23: "❗",
+	0x7A24: "⛏",
+	0x7A25: "⛐",
+	0x7A26: "⛑",
+	0x7A28: "⛒",
+	0x7A29: "⛕",
+	0x7A2A: "⛓",
+	0x7A2B: "⛔",
+	0x7A30: "🅿",
+	0x7A31: "🆊",
+	0x7A34: "⛖",
+	0x7A35: "⛗",
+	0x7A36: "⛘",
+	0x7A37: "⛙",
+	0x7A38: "⛚",
+	0x7A39: "⛛",
+	0x7A3A: "⛜",
+	0x7A3B: "⛝",
+	0x7A3C: "⛞",
+	0x7A3D: "⛟",
+	0x7A3E: "⛠",
+	0x7A3F: "⛡",
+	0x7A40: "⭕",
+	0x7A41: "㉈",
+	0x7A42: "㉉",
+	0x7A43: "㉊",
+	0x7A44: "㉋",
+	0x7A45: "㉌",
+	0x7A46: "㉍",
+	0x7A47: "㉎",
+	0x7A48: "㉏",
+	0x7A4D: "⒑",
+	0x7A4E: "⒒",
+	0x7A4F: "⒓",
 	0x7A50: "【HV】",
 	0x7A51: "【SD】",
 	0x7A52: "【Ｐ】",
@@ -508,6 +609,55 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x7A72: "【PPV】",
 	0x7A73: "（秘）",
 	0x7A74: "ほか",
+	0x7B21: "⛣",
+	0x7B22: "⭖",
+	0x7B23: "⭗",
+	0x7B24: "⭘",
+	0x7B25: "⭙",
+	0x7B26: "☓",
+	0x7B27: "㊋",
+	0x7B28: "〒",
+	0x7B29: "⛨",
+	0x7B2A: "㉆",
+	0x7B2B: "㉅",
+	0x7B2C: "⛩",
+	0x7B2D: "࿖",
+	0x7B2E: "⛪",
+	0x7B2F: "⛫",
+	0x7B30: "⛬",
+	0x7B31: "♨",
+	0x7B32: "⛭",
+	0x7B33: "⛮",
+	0x7B34: "⛯",
+	0x7B35: "⚓",
+	0x7B36: "✈",
+	0x7B37: "⛰",
+	0x7B38: "⛱",
+	0x7B39: "⛲",
+	0x7B3A: "⛳",
+	0x7B3B: "⛴",
+	0x7B3C: "⛵",
+	0x7B3D: "🅗",
+	0x7B3E: "Ⓓ",
+	0x7B3F: "Ⓢ",
+	0x7B40: "⛶",
+	0x7B41: "🅟",
+	0x7B42: "🆋",
+	0x7B43: "🆍",
+	0x7B44: "🆌",
+	0x7B45: "🅹",
+	0x7B46: "⛷",
+	0x7B47: "⛸",
+	0x7B48: "⛹",
+	0x7B49: "⛺",
+	0x7B4A: "🅻",
+	0x7B4B: "☎",
+	0x7B4C: "⛻",
+	0x7B4D: "⛼",
+	0x7B4E: "⛽",
+	0x7B4F: "⛾",
+	0x7B50: "🅼",
+	0x7B51: "⛿",
 	0x7C21: "→",
 	0x7C22: "←",
 	0x7C23: "↑",
@@ -644,8 +794,6 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x7D4B: "㎞",
 	0x7D4C: "㎢",
 	0x7D4D: "㍱",
-	0x7D4E: "・",
-	0x7D4F: "・",
 	0x7D50: "1/2",
 	0x7D51: "0/3",
 	0x7D52: "1/3",
@@ -686,8 +834,8 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x7D75: "⚡",
 	0x7D76: "(雷雨)",
 	0x7D77: "　",
-	0x7D78: "・",
-	0x7D79: "・",
+	0x7D78: "⚞",
+	0x7D79: "⚟",
 	0x7D7A: "♬",
 	0x7D7B: "☎",
 	0x7E21: "Ⅰ",
@@ -784,7 +932,7 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x7E7C: "⓬",
 	0x7E7D: "㉛",
 	0x7521: "㐂",
-	0x7522: "亭",
+	0x7522: "𠅘",
 	0x7523: "份",
 	0x7524: "仿",
 	0x7525: "侚",
@@ -797,7 +945,7 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x752C: "卡",
 	0x752D: "卬",
 	0x752E: "詹",
-	0x752F: "吉",
+	0x752F: "𠮷",
 	0x7530: "呍",
 	0x7531: "咖",
 	0x7532: "咜",
@@ -821,7 +969,7 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x7544: "彅",
 	0x7545: "德",
 	0x7546: "怗",
-	0x7547: "恵",
+	0x7547: "恵",
 	0x7548: "愰",
 	0x7549: "昤",
 	0x754A: "曈",
@@ -829,15 +977,15 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x754C: "曺",
 	0x754D: "曻",
 	0x754E: "桒",
-	0x754F: "・",
+	0x754F: "鿄",
 	0x7550: "椑",
 	0x7551: "椻",
 	0x7552: "橅",
 	0x7553: "檑",
 	0x7554: "櫛",
-	0x7555: "・",
-	0x7556: "・",
-	0x7557: "・",
+	0x7555: "𣏌",
+	0x7556: "𣏾",
+	0x7557: "𣗄",
 	0x7558: "毱",
 	0x7559: "泠",
 	0x755A: "洮",
@@ -849,13 +997,13 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x7560: "潞",
 	0x7561: "濹",
 	0x7562: "灤",
-	0x7563: "・",
-	0x7564: "・",
+	0x7563: "\uFA6C", // compatibility ideograph of 85-68
+	0x7564: "\U000242EE",
 	0x7565: "煇",
 	0x7566: "燁",
 	0x7567: "爀",
 	0x7568: "玟",
-	0x7569: "・",
+	0x7569: "玨",
 	0x756A: "珉",
 	0x756B: "珖",
 	0x756C: "琛",
@@ -875,9 +1023,9 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x757A: "磠",
 	0x757B: "祇",
 	0x757C: "禮",
-	0x757D: "・",
-	0x757E: "・",
-	0x7621: "・",
+	0x757D: "鿆",
+	0x757E: "䄃",
+	0x7621: "鿅",
 	0x7622: "秚",
 	0x7623: "稞",
 	0x7624: "筿",
@@ -887,7 +1035,7 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 	0x7628: "羡",
 	0x7629: "脘",
 	0x762A: "脺",
-	0x762B: "・",
+	0x762B: "舘",
 	0x762C: "芮",
 	0x762D: "葛",
 	0x762E: "蓜",
@@ -923,67 +1071,67 @@ var additionalSymbolSet = &additionalSymbolMap{map[uint16]string{
 }}
 
 var jisX0201KatakanaSet = &singleByteGraphicMap{map[byte]string{
-	0x21: "。",
-	0x22: "「",
-	0x23: "」",
-	0x24: "、",
-	0x25: "・",
-	0x26: "ヲ",
-	0x27: "ァ",
-	0x28: "ィ",
-	0x29: "ゥ",
-	0x2A: "ェ",
-	0x2B: "ォ",
-	0x2C: "ャ",
-	0x2D: "ュ",
-	0x2E: "ョ",
-	0x2F: "ッ",
-	0x30: "ー",
-	0x31: "ア",
-	0x32: "イ",
-	0x33: "ウ",
-	0x34: "エ",
-	0x35: "オ",
-	0x36: "カ",
-	0x37: "キ",
-	0x38: "ク",
-	0x39: "ケ",
-	0x3A: "コ",
-	0x3B: "サ",
-	0x3C: "シ",
-	0x3D: "ス",
-	0x3E: "セ",
-	0x3F: "ソ",
-	0x40: "タ",
-	0x41: "チ",
-	0x42: "ツ",
-	0x43: "テ",
-	0x44: "ト",
-	0x45: "ナ",
-	0x46: "ニ",
-	0x47: "ヌ",
-	0x48: "ネ",
-	0x49: "ノ",
-	0x4A: "ハ",
-	0x4B: "ヒ",
-	0x4C: "フ",
-	0x4D: "ヘ",
-	0x4E: "ホ",
-	0x4F: "マ",
-	0x50: "ミ",
-	0x51: "ム",
-	0x52: "メ",
-	0x53: "モ",
-	0x54: "ヤ",
-	0x55: "ユ",
-	0x56: "ヨ",
-	0x57: "ラ",
-	0x58: "リ",
-	0x59: "ル",
-	0x5A: "レ",
-	0x5B: "ロ",
-	0x5C: "ワ",
-	0x5D: "ン",
-	0x5E: "゛",
-	0x5F: "゜",
+	0x21: "｡",
+	0x22: "｢",
+	0x23: "｣",
+	0x24: "､",
+	0x25: "･",
+	0x26: "ｦ",
+	0x27: "ｧ",
+	0x28: "ｨ",
+	0x29: "ｩ",
+	0x2A: "ｪ",
+	0x2B: "ｫ",
+	0x2C: "ｬ",
+	0x2D: "ｭ",
+	0x2E: "ｮ",
+	0x2F: "ｯ",
+	0x30: "ｰ",
+	0x31: "ｱ",
+	0x32: "ｲ",
+	0x33: "ｳ",
+	0x34: "ｴ",
+	0x35: "ｵ",
+	0x36: "ｶ",
+	0x37: "ｷ",
+	0x38: "ｸ",
+	0x39: "ｹ",
+	0x3A: "ｺ",
+	0x3B: "ｻ",
+	0x3C: "ｼ",
+	0x3D: "ｽ",
+	0x3E: "ｾ",
+	0x3F: "ｿ",
+	0x40: "ﾀ",
+	0x41: "ﾁ",
+	0x42: "ﾂ",
+	0x43: "ﾃ",
+	0x44: "ﾄ",
+	0x45: "ﾅ",
+	0x46: "ﾆ",
+	0x47: "ﾇ",
+	0x48: "ﾈ",
+	0x49: "ﾉ",
+	0x4A: "ﾊ",
+	0x4B: "ﾋ",
+	0x4C: "ﾌ",
+	0x4D: "ﾍ",
+	0x4E: "ﾎ",
+	0x4F: "ﾏ",
+	0x50: "ﾐ",
+	0x51: "ﾑ",
+	0x52: "ﾒ",
+	0x53: "ﾓ",
+	0x54: "ﾔ",
+	0x55: "ﾕ",
+	0x56: "ﾖ",
+	0x57: "ﾗ",
+	0x58: "ﾘ",
+	0x59: "ﾙ",
+	0x5A: "ﾚ",
+	0x5B: "ﾛ",
+	0x5C: "ﾜ",
+	0x5D: "ﾝ",
+	0x5E: "ﾞ",
+	0x5F: "ﾟ",
 }}
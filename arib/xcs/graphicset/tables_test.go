@@ -37,8 +37,25 @@ func TestAdditionalSymbolSetGet(t *testing.T) {
 		buf  []byte
 		size int
 	}{
-		{byte(0x7D), byte(0x2C), []byte("㍻"), 2},
-		{byte(0xFF), byte(0xFF), []byte("・"), 2},
+		{byte(0x75), byte(0x21), []byte("\u3402"), 2},
+		{byte(0x75), byte(0x2F), []byte("\U00020BB7"), 2},
+		{byte(0x75), byte(0x40), []byte("\uFA11"), 2},
+		{byte(0x75), byte(0x63), []byte("\uFA6C"), 2},
+		{byte(0x75), byte(0x64), []byte("\U000242EE"), 2},
+		{byte(0x76), byte(0x4B), []byte("\u9EB5"), 2},
+		{byte(0x76), byte(0x4C), []byte("\uFFFD"), 2},
+		{byte(0x7A), byte(0x21), []byte("\u26CC"), 2},
+		{byte(0x7A), byte(0x27), []byte("\uFFFD"), 2},
+		{byte(0x7A), byte(0x50), []byte("【HV】"), 2},
+		{byte(0x7B), byte(0x21), []byte("\u26E3"), 2},
+		{byte(0x7B), byte(0x51), []byte("\u26FF"), 2},
+		{byte(0x7C), byte(0x21), []byte("\u2192"), 2},
+		{byte(0x7D), byte(0x2C), []byte("\u337B"), 2},
+		{byte(0x7D), byte(0x4E), []byte("\uFFFD"), 2},
+		{byte(0x7E), byte(0x21), []byte("\u2160"), 2},
+		{byte(0x7E), byte(0x7D), []byte("\u325B"), 2},
+		{byte(0x7E), byte(0x7E), []byte("\uFFFD"), 2},
+		{byte(0xFF), byte(0xFF), []byte("\uFFFD"), 2},
 	} {
 		i, tc := i, tc
 		t.Run("", func(t *testing.T) {
@@ -53,6 +70,42 @@ func TestAdditionalSymbolSetGet(t *testing.T) {
 	}
 }
 
+// additionalSymbolCells is the ranges of the defined cells of the additional
+// symbols and kanji of ARIB STD-B24 by the rows.
+var additionalSymbolCells = map[byte][][2]int{
+	85: {{1, 94}},
+	86: {{1, 43}},
+	90: {{1, 6}, {8, 11}, {16, 17}, {20, 40}, {45, 84}},
+	91: {{1, 49}},
+	92: {{1, 91}},
+	93: {{1, 45}, {48, 91}},
+	94: {{1, 93}},
+}
+
+func TestAdditionalSymbolSetDefined(t *testing.T) {
+	for row := byte(85); row <= 94; row++ {
+		row := row
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			for cell := 1; cell <= 94; cell++ {
+				want := false
+				for _, r := range additionalSymbolCells[row] {
+					if r[0] <= cell && cell <= r[1] {
+						want = true
+					}
+				}
+				b1, b2 := row+0x20, byte(cell+0x20)
+				for _, gs := range []GraphicSet{additionalSymbolSet, kanjiSet} {
+					if got := Defined(gs, b1, b2); got != want {
+						t.Errorf("%d-%d: Defined(%T, 0x%X, 0x%X) => %t, want %t", row, cell, gs, b1, b2, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestKanjiSetGet(t *testing.T) {
 	for i, tc := range []struct {
 		b1   byte
@@ -73,3 +126,48 @@ func TestKanjiSetGet(t *testing.T) {
 		})
 	}
 }
+
+func TestJISKanjiSetGet(t *testing.T) {
+	for i, tc := range []struct {
+		final byte
+		b1    byte
+		b2    byte
+		buf   []byte
+		size  int
+	}{
+		{JISKanji1, 0x33, 0x5A, []byte("楽"), 2},
+		{JISKanji1, 0x24, 0x77, []byte("か\u309A"), 2},
+		{JISKanji1, 0x2D, 0x21, []byte("①"), 2},
+		{JISKanji1, 0x2E, 0x21, []byte("\u4FF1"), 2},
+		{JISKanji1, 0x4F, 0x54, []byte("\U00020B9F"), 2},
+		{JISKanji1, 0x7E, 0x79, []byte("\u9FA2"), 2},
+		{JISKanji2, 0x21, 0x21, []byte("\U00020089"), 2},
+		{JISKanji2, 0x22, 0x21, []byte("\uFFFD"), 2},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			got, size := GSetMap[tc.final].Get(tc.b1, tc.b2)
+			if !bytes.Equal(got, tc.buf) || size != tc.size {
+				t.Errorf("%d: GSetMap[0x%X].Get(0x%X, 0x%X) => %s, %d, want %s, %d", i, tc.final, tc.b1, tc.b2, string(got), size, string(tc.buf), tc.size)
+			}
+		})
+	}
+}
+
+func TestKanjiSetGetAllocs(t *testing.T) {
+	n := testing.AllocsPerRun(100, func() {
+		kanjiSet.Get(0x33, 0x5A)
+	})
+	if n != 0 {
+		t.Errorf("kanjiSet.Get allocates %v times, want 0", n)
+	}
+}
+
+func BenchmarkKanjiSetGet(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		kanjiSet.Get(byte(0x30+i%0x40), byte(0x21+i%0x5E))
+	}
+}
@@ -0,0 +1,75 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/transform"
+)
+
+// LatinEncoding is the Latin character set of ISDB-Tb, which consists of the
+// graphic sets of ISO/IEC 8859-15 and the control codes of ARIB.
+// Texts in SI of ISDB-Tb networks are decoded by passing it to
+// arib.String.WithEncoding, e.g. d.Name().WithEncoding(xcs.LatinEncoding).
+//
+// Its encoder writes ISO/IEC 8859-15 with the initial invocations, so the
+// characters 0xA0 and 0xFF, which are control codes of ARIB, are not
+// encodable.
+var LatinEncoding encoding.Encoding = &Encoding{
+	decoder: func() *encoding.Decoder {
+		return NewDecoder(WithLatin())
+	},
+	encoder: func() *encoding.Encoder {
+		return &encoding.Encoder{Transformer: &latinEncoder{}}
+	},
+	options: []Option{WithLatin()},
+}
+
+// WithLatin returns an Option which decodes the Latin character set of
+// ISDB-Tb instead of the Japanese one.
+func WithLatin() Option {
+	return func(d *xcsDecoder) {
+		d.latin = true
+	}
+}
+
+type latinEncoder struct{ transform.NopResetter }
+
+func (e *latinEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if !utf8.FullRune(src[nSrc:]) && !atEOF {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		r, size := utf8.DecodeRune(src[nSrc:])
+		if r == utf8.RuneError && size <= 1 {
+			return nDst, nSrc, encoding.ErrInvalidUTF8
+		}
+		b, ok := charmap.ISO8859_15.EncodeRune(r)
+		if !ok || b < SP || b == DEL || b >= BKF && b <= CC1000 || b == CC1515 {
+			return nDst, nSrc, fmt.Errorf("arib: latin encoding does not support %q", r)
+		}
+		if nDst >= len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		dst[nDst] = b
+		nDst++
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
@@ -0,0 +1,83 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"testing"
+)
+
+func TestDecodeLatin(t *testing.T) {
+	for i, tc := range []struct {
+		src []byte
+		dst string
+	}{
+		{[]byte("Jornal Nacional"), "Jornal Nacional"},
+		// ISO/IEC 8859-15 in GR
+		{[]byte{0x4E, 0x6F, 0x74, 0xED, 0x63, 0x69, 0x61, 0x73, 0x20, 0xA4, 0x31, 0x30}, "Notícias €10"},
+		{[]byte{0x53, 0xE3, 0x6F, 0x20, 0x50, 0x61, 0x75, 0x6C, 0x6F}, "São Paulo"},
+		// Latin extension invoked to GL by LS2 (ESC 0x6E)
+		{[]byte{0x61, 0x1B, 0x6E, 0x63, 0x0F, 0x62}, "aãb"},
+		// control codes of ARIB
+		{[]byte{0x89, 0x41, 0x8A, 0x42}, "AB"},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			got, err := LatinEncoding.NewDecoder().Bytes(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.dst {
+				t.Errorf("%d: Decode(0x%X) => %s, want %s", i, tc.src, got, tc.dst)
+			}
+		})
+	}
+}
+
+func TestEncodeLatin(t *testing.T) {
+	for i, tc := range []struct {
+		src string
+		ok  bool
+	}{
+		{"Jornal Nacional", true},
+		{"Notícias €10 São Paulo", true},
+		{"Œuvre", true},
+		{"日本", false},
+		{"\u0085", false},
+		{"\u007F", false},
+		{"\u001F", false},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			b, err := LatinEncoding.NewEncoder().String(tc.src)
+			if (err == nil) != tc.ok {
+				t.Fatalf("%d: Encode(%q) => %v, want ok %t", i, tc.src, err, tc.ok)
+			}
+			if !tc.ok {
+				return
+			}
+			got, err := LatinEncoding.NewDecoder().String(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.src {
+				t.Errorf("%d: Decode(Encode(%q)) => %q", i, tc.src, got)
+			}
+		})
+	}
+}
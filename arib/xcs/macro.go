@@ -0,0 +1,143 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"errors"
+
+	"github.com/drillbits/go-arib/arib/xcs/graphicset"
+	"golang.org/x/text/transform"
+)
+
+// Parameters of MACRO
+const (
+	macroDefine        = 0x40 // Macro definition start
+	macroDefineExecute = 0x41 // Macro definition and execution start
+	macroEnd           = 0x4F // Macro definition end
+)
+
+// maxMacroDepth is the maximum depth of macros which invoke other macros.
+const maxMacroDepth = 4
+
+var errMacroDepth = errors.New("arib: macro nesting is too deep")
+
+// defaultMacros is the default macro statements for the codes 0x60 to 0x6F.
+var defaultMacros = map[byte][]byte{
+	0x60: {ESC, 0x24, 0x42, ESC, 0x29, 0x4A, ESC, 0x2A, 0x30, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x61: {ESC, 0x24, 0x42, ESC, 0x29, 0x31, ESC, 0x2A, 0x30, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x62: {ESC, 0x24, 0x42, ESC, 0x29, 0x20, 0x41, ESC, 0x2A, 0x30, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x63: {ESC, 0x28, 0x32, ESC, 0x29, 0x34, ESC, 0x2A, 0x35, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x64: {ESC, 0x28, 0x32, ESC, 0x29, 0x33, ESC, 0x2A, 0x35, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x65: {ESC, 0x28, 0x32, ESC, 0x29, 0x20, 0x41, ESC, 0x2A, 0x35, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x66: {ESC, 0x28, 0x20, 0x41, ESC, 0x29, 0x20, 0x42, ESC, 0x2A, 0x20, 0x43, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x67: {ESC, 0x28, 0x20, 0x44, ESC, 0x29, 0x20, 0x45, ESC, 0x2A, 0x20, 0x46, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x68: {ESC, 0x28, 0x20, 0x47, ESC, 0x29, 0x20, 0x48, ESC, 0x2A, 0x20, 0x49, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x69: {ESC, 0x28, 0x20, 0x4A, ESC, 0x29, 0x20, 0x4B, ESC, 0x2A, 0x20, 0x4C, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x6A: {ESC, 0x28, 0x20, 0x4D, ESC, 0x29, 0x20, 0x4E, ESC, 0x2A, 0x20, 0x4F, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x6B: {ESC, 0x24, 0x42, ESC, 0x29, 0x20, 0x42, ESC, 0x2A, 0x30, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x6C: {ESC, 0x24, 0x42, ESC, 0x29, 0x20, 0x43, ESC, 0x2A, 0x30, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x6D: {ESC, 0x24, 0x42, ESC, 0x29, 0x20, 0x44, ESC, 0x2A, 0x30, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x6E: {ESC, 0x28, 0x31, ESC, 0x29, 0x30, ESC, 0x2A, 0x4A, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+	0x6F: {ESC, 0x28, 0x4A, ESC, 0x29, 0x32, ESC, 0x2A, 0x20, 0x41, ESC, 0x2B, 0x20, 0x70, LS0, ESC, 0x7D},
+}
+
+// macroSet is the graphic set of macros designated by the final byte Macro.
+// Its codes are expanded to the macro statements by the decoder.
+type macroSet struct{}
+
+func (macroSet) Get(b1, _ byte) ([]byte, int) {
+	return nil, 1
+}
+
+// readMACRO reads the macro definition from MACRO to MACRO 0x4F and reports
+// its size. It executes the macro statement if P1 is 0x41.
+//
+//	MACRO P1 C S1 .. Sn MACRO 0x4F
+func (d *xcsDecoder) readMACRO(pos int) ([]byte, int, error) {
+	if pos+1 >= len(d.buf) {
+		return nil, 1, transform.ErrShortSrc
+	}
+	p1 := d.buf[pos+1]
+	if p1 != macroDefine && p1 != macroDefineExecute {
+		// MACRO 0x4F without definition
+		return nil, 2, nil
+	}
+	for i := pos + 2; i+1 < len(d.buf); i++ {
+		if d.buf[i] != MACRO || d.buf[i+1] != macroEnd {
+			continue
+		}
+		size := i + 2 - pos
+		if i == pos+2 {
+			// no macro code
+			return nil, size, nil
+		}
+		code := d.buf[pos+2] & 0x7F
+		if d.macros == nil {
+			d.macros = map[byte][]byte{}
+		}
+		d.macros[code] = append([]byte(nil), d.buf[pos+3:i]...)
+		if p1 == macroDefine {
+			return nil, size, nil
+		}
+		buf, err := d.expandMacro(code)
+		return buf, size, err
+	}
+	return nil, 1, transform.ErrShortSrc
+}
+
+// expandMacro decodes the macro statement of the code with the current state,
+// and returns the output.
+func (d *xcsDecoder) expandMacro(code byte) ([]byte, error) {
+	stmt, ok := d.macros[code]
+	if !ok {
+		stmt, ok = defaultMacros[code]
+	}
+	if !ok {
+		return nil, nil
+	}
+	if d.macroDepth >= maxMacroDepth {
+		return nil, errMacroDepth
+	}
+
+	buf, written := d.buf, d.written
+	d.buf = stmt
+	d.macroDepth++
+	defer func() {
+		d.buf, d.written = buf, written
+		d.macroDepth--
+	}()
+
+	var out []byte
+	for pos, size := 0, 0; pos < len(stmt); pos += size {
+		var b []byte
+		var err error
+		b, size, err = d.read(pos)
+		if err == transform.ErrShortSrc {
+			return nil, errInvalidARIBXCS
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b...)
+		d.written += len(b)
+	}
+	return out, nil
+}
+
+// isMacroSet reports whether the graphic set is the macro set.
+func isMacroSet(gs graphicset.GraphicSet) bool {
+	_, ok := gs.(macroSet)
+	return ok
+}
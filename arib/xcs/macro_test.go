@@ -0,0 +1,101 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/text/transform"
+)
+
+func TestDecodeMacro(t *testing.T) {
+	for i, tc := range []struct {
+		name string
+		src  []byte
+		dst  string
+		err  error
+	}{
+		{
+			name: "DefaultMacro",
+			src: []byte{
+				0x1B, 0x2B, 0x20, 0x70, // G3 <- Macro
+				0x1D, 0x6E, // Katakana, Hiragana, Alphanumeric, Macro
+				0x22, 0xC1,
+			},
+			dst: "アＡ",
+		},
+		{
+			name: "DefaultMacroInGR",
+			src: []byte{
+				0x1B, 0x2B, 0x20, 0x70, // G3 <- Macro
+				0x1B, 0x7C, 0xEE, // LS3R, Katakana, Hiragana, Alphanumeric, Macro
+				0x22, 0xC1,
+			},
+			dst: "アＡ",
+		},
+		{
+			name: "Define",
+			src: []byte{
+				0x95, 0x40, 0x21, 0xAA, 0xAB, 0x95, 0x4F, // define 0x21
+				0xA2,
+				0x1B, 0x2B, 0x20, 0x70, 0x1D, 0x21, // invoke 0x21
+			},
+			dst: "あおか",
+		},
+		{
+			name: "DefineAndExecute",
+			src: []byte{
+				0x95, 0x41, 0x21, 0xAA, 0xAB, 0x95, 0x4F, // define and execute 0x21
+				0xA2,
+			},
+			dst: "おかあ",
+		},
+		{
+			name: "Redefine",
+			src: []byte{
+				0x95, 0x40, 0x60, 0xAA, 0x95, 0x4F, // define 0x60
+				0x1B, 0x2B, 0x20, 0x70, 0x1D, 0x60, // invoke 0x60
+			},
+			dst: "お",
+		},
+		{
+			name: "Unterminated",
+			src:  []byte{0xA2, 0x95, 0x40, 0x21, 0xAA, 0xAB, 0x95},
+			err:  errInvalidARIBXCS,
+		},
+		{
+			name: "Recursive",
+			src: []byte{
+				0x1B, 0x2B, 0x20, 0x70, // G3 <- Macro
+				0x95, 0x41, 0x21, 0x1D, 0x21, 0x95, 0x4F, // 0x21 invokes itself
+			},
+			err: errMacroDepth,
+		},
+	} {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _, err := transform.Bytes(newXCSDecoder(), tc.src)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("%d: Decode(0x%X) => _, %v, want _, %v", i, tc.src, err, tc.err)
+			}
+			if err == nil && string(got) != tc.dst {
+				t.Errorf("%d: Decode(0x%X) => %s, want %s", i, tc.src, got, tc.dst)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"sort"
+)
+
+// Span is a range of bytes [Start, End) in the source.
+type Span struct {
+	Start int
+	End   int
+}
+
+// MappedText is a decoded text with the positions of its runes in the
+// source.
+type MappedText struct {
+	// Text is the decoded text.
+	Text string
+
+	// Runes is the span of the code in the source of each rune of Text. The
+	// runes of the output of a code, e.g. "【字】", have the same span.
+	Runes []Span
+
+	// Size is the size of the source.
+	Size int
+}
+
+// DecodeMapped decodes b with the options, and returns the text with the
+// positions of its runes in b. Control codes and escape sequences which
+// have no output are not mapped to any rune. Widths are not normalized even
+// with WithWidth.
+func DecodeMapped(b []byte, opts ...Option) (*MappedText, error) {
+	traces, err := DecodeTrace(b, opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := &MappedText{Size: len(b)}
+	var text []byte
+	for _, tr := range traces {
+		span := Span{tr.Offset, tr.Offset + len(tr.Bytes)}
+		for range tr.Text {
+			t.Runes = append(t.Runes, span)
+		}
+		text = append(text, tr.Text...)
+	}
+	t.Text = string(text)
+	return t, nil
+}
+
+// Source returns the span of the source which produces the runes [i, j) of
+// the text. The span includes the control codes and escape sequences between
+// the runes. If i equals to j, it returns the empty span at the code of the
+// rune i.
+func (t *MappedText) Source(i, j int) Span {
+	if i >= j {
+		if i < len(t.Runes) {
+			return Span{t.Runes[i].Start, t.Runes[i].Start}
+		}
+		return Span{t.Size, t.Size}
+	}
+	return Span{t.Runes[i].Start, t.Runes[j-1].End}
+}
+
+// RuneRange returns the range of the runes [i, j) of the text produced by
+// the codes in the span of the source. If no rune is produced, i equals to j
+// and it is the index of the first rune after the span.
+func (t *MappedText) RuneRange(s Span) (i, j int) {
+	i = sort.Search(len(t.Runes), func(n int) bool {
+		return t.Runes[n].End > s.Start
+	})
+	j = sort.Search(len(t.Runes), func(n int) bool {
+		return t.Runes[n].Start >= s.End
+	})
+	if j < i {
+		j = i
+	}
+	return i, j
+}
@@ -0,0 +1,83 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeMapped(t *testing.T) {
+	// あ ESC ( J A 【字】 CSI い
+	src := []byte{0xA2, 0x1B, 0x28, 0x4A, 0x41, 0x1B, 0x24, 0x3B, 0x7A, 0x56, 0x9B, 0x31, 0x20, 0x58, 0xA4}
+	got, err := DecodeMapped(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "あＡ【字】い"; got.Text != want {
+		t.Errorf("DecodeMapped(0x%X).Text => %s, want %s", src, got.Text, want)
+	}
+	want := []Span{{0, 1}, {4, 5}, {8, 10}, {8, 10}, {8, 10}, {14, 15}}
+	if !reflect.DeepEqual(got.Runes, want) {
+		t.Errorf("DecodeMapped(0x%X).Runes => %v, want %v", src, got.Runes, want)
+	}
+
+	for i, tc := range []struct {
+		i, j int
+		span Span
+	}{
+		{0, 1, Span{0, 1}},
+		{0, 2, Span{0, 5}},
+		{2, 5, Span{8, 10}},
+		{3, 4, Span{8, 10}},
+		{4, 6, Span{8, 15}},
+		{1, 1, Span{4, 4}},
+		{6, 6, Span{15, 15}},
+	} {
+		if span := got.Source(tc.i, tc.j); span != tc.span {
+			t.Errorf("%d: Source(%d, %d) => %v, want %v", i, tc.i, tc.j, span, tc.span)
+		}
+	}
+
+	for i, tc := range []struct {
+		span Span
+		i, j int
+	}{
+		{Span{0, 1}, 0, 1},
+		{Span{1, 4}, 1, 1},
+		{Span{1, 5}, 1, 2},
+		{Span{9, 10}, 2, 5},
+		{Span{10, 14}, 5, 5},
+		{Span{0, 15}, 0, 6},
+	} {
+		if gi, gj := got.RuneRange(tc.span); gi != tc.i || gj != tc.j {
+			t.Errorf("%d: RuneRange(%v) => %d, %d, want %d, %d", i, tc.span, gi, gj, tc.i, tc.j)
+		}
+	}
+
+	// G2 <- Mosaic C, the non-spacing mosaic flushed at EOF
+	src = []byte{0xA2, 0x1B, 0x2A, 0x34, 0xA1}
+	got, err = DecodeMapped(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "あ\U0001FB00"; got.Text != want {
+		t.Errorf("DecodeMapped(0x%X).Text => %s, want %s", src, got.Text, want)
+	}
+	want = []Span{{0, 1}, {4, 5}}
+	if !reflect.DeepEqual(got.Runes, want) {
+		t.Errorf("DecodeMapped(0x%X).Runes => %v, want %v", src, got.Runes, want)
+	}
+}
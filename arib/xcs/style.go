@@ -0,0 +1,141 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import "golang.org/x/text/transform"
+
+// Size is a character size.
+type Size int
+
+// Character sizes
+const (
+	SizeNormal       Size = iota // NSZ
+	SizeSmall                    // SSZ
+	SizeMiddle                   // MSZ
+	SizeTiny                     // SZX 0x60
+	SizeDoubleHeight             // SZX 0x41
+	SizeDoubleWidth              // SZX 0x44
+	SizeDouble                   // SZX 0x45
+	SizeSpecial1                 // SZX 0x6B
+	SizeSpecial2                 // SZX 0x64
+)
+
+// szxSizes maps a parameter of SZX to a character size.
+var szxSizes = map[byte]Size{
+	0x60: SizeTiny,
+	0x41: SizeDoubleHeight,
+	0x44: SizeDoubleWidth,
+	0x45: SizeDouble,
+	0x6B: SizeSpecial1,
+	0x64: SizeSpecial2,
+}
+
+// Flash is a flashing control designated by FLC.
+type Flash int
+
+// Flashing controls
+const (
+	FlashNone     Flash = iota // FLC 0x4F
+	FlashNormal                // FLC 0x40
+	FlashInverted              // FLC 0x47
+)
+
+// Polarity is a pattern polarity designated by POL.
+type Polarity int
+
+// Pattern polarities
+const (
+	PolarityNormal    Polarity = iota // POL 0x40
+	PolarityInverted1                 // POL 0x41
+	PolarityInverted2                 // POL 0x42
+)
+
+// Highlight is a set of enclosure lines designated by HLC.
+type Highlight byte
+
+// Enclosure lines
+const (
+	HighlightBottom Highlight = 1 << iota
+	HighlightRight
+	HighlightTop
+	HighlightLeft
+)
+
+// Ornament is an ornament designated by ORN.
+type Ornament int
+
+// Ornaments
+const (
+	OrnamentNone    Ornament = iota // ORN 0
+	OrnamentHemming                 // ORN 1
+	OrnamentShade                   // ORN 2
+	OrnamentHollow                  // ORN 3
+)
+
+// Style is the presentation of characters.
+//
+// Colors are indices of the colour map, which is the palette number
+// multiplied by 16 plus the colour number in the palette.
+type Style struct {
+	Foreground     int
+	Background     int
+	HalfForeground int
+	HalfBackground int
+	Size           Size
+	Flash          Flash
+	Polarity       Polarity
+	Underline      bool
+	Highlight      Highlight
+	Ornament       Ornament
+	OrnamentColor  int
+
+	// Ruby reports whether the characters may be ruby, which is usually
+	// in small size.
+	Ruby bool
+}
+
+// initialStyle is white characters on transparent background of palette 0.
+var initialStyle = Style{
+	Foreground:     7,
+	Background:     8,
+	HalfForeground: 7,
+	HalfBackground: 8,
+}
+
+// Run is a sequence of characters in the same style.
+type Run struct {
+	Text  string
+	Style Style
+}
+
+// DecodeStyled decodes b like the decoder of XCSEncoding, and returns the
+// text as runs of the same style.
+func DecodeStyled(b []byte) ([]Run, error) {
+	var runs []Run
+	d := newXCSDecoder()
+	d.onOutput = func(buf []byte) {
+		style := d.style
+		style.Ruby = style.Size == SizeSmall
+		if n := len(runs); n > 0 && runs[n-1].Style == style {
+			runs[n-1].Text += string(buf)
+			return
+		}
+		runs = append(runs, Run{string(buf), style})
+	}
+	if _, _, err := transform.Bytes(d, b); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
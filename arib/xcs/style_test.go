@@ -0,0 +1,91 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeStyled(t *testing.T) {
+	for i, tc := range []struct {
+		name string
+		src  []byte
+		runs []Run
+	}{
+		{
+			name: "Plain",
+			src:  []byte{0xAA, 0xAB},
+			runs: []Run{
+				{"おか", initialStyle},
+			},
+		},
+		{
+			name: "Color",
+			src:  []byte{0x83, 0xAA, 0x90, 0x20, 0x41, 0x81, 0xAB, 0x90, 0x51, 0xA2},
+			runs: []Run{
+				{"お", Style{Foreground: 3, Background: 8, HalfForeground: 7, HalfBackground: 8}},
+				{"か", Style{Foreground: 0x11, Background: 8, HalfForeground: 7, HalfBackground: 8}},
+				{"あ", Style{Foreground: 0x11, Background: 0x11, HalfForeground: 7, HalfBackground: 8}},
+			},
+		},
+		{
+			name: "SizeAndRuby",
+			src:  []byte{0x88, 0xAA, 0x8A, 0xAB, 0x8B, 0x45, 0xA2},
+			runs: []Run{
+				{"お", Style{Foreground: 7, Background: 8, HalfForeground: 7, HalfBackground: 8, Size: SizeSmall, Ruby: true}},
+				{"か", initialStyle},
+				{"あ", Style{Foreground: 7, Background: 8, HalfForeground: 7, HalfBackground: 8, Size: SizeDouble}},
+			},
+		},
+		{
+			name: "FlashAndHighlight",
+			src:  []byte{0x91, 0x40, 0x97, 0x4F, 0xAA, 0x91, 0x4F, 0x97, 0x40, 0x9A, 0xAB, 0x99, 0x93, 0x41, 0xA2},
+			runs: []Run{
+				{"お", Style{Foreground: 7, Background: 8, HalfForeground: 7, HalfBackground: 8, Flash: FlashNormal, Highlight: HighlightBottom | HighlightRight | HighlightTop | HighlightLeft}},
+				{"か", Style{Foreground: 7, Background: 8, HalfForeground: 7, HalfBackground: 8, Underline: true}},
+				{"あ", Style{Foreground: 7, Background: 8, HalfForeground: 7, HalfBackground: 8, Polarity: PolarityInverted1}},
+			},
+		},
+		{
+			name: "Ornament",
+			src:  []byte{0x9B, 0x31, 0x3B, 0x30, 0x31, 0x30, 0x30, 0x20, 0x63, 0xAA},
+			runs: []Run{
+				{"お", Style{Foreground: 7, Background: 8, HalfForeground: 7, HalfBackground: 8, Ornament: OrnamentHemming, OrnamentColor: 0x10}},
+			},
+		},
+		{
+			name: "TrailingMosaic",
+			src:  []byte{0xAA, 0x1B, 0x2A, 0x34, 0x83, 0xA1},
+			runs: []Run{
+				{"お", initialStyle},
+				{"\U0001FB00", Style{Foreground: 3, Background: 8, HalfForeground: 7, HalfBackground: 8}},
+			},
+		},
+	} {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			runs, err := DecodeStyled(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(runs, tc.runs) {
+				t.Errorf("%d: DecodeStyled(0x%X) => %+v, want %+v", i, tc.src, runs, tc.runs)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/transform"
+)
+
+// Forms of TIME other than the presentation time
+const (
+	TimeWait        = 0x20 // Process wait, TIME 0x20 P1
+	TimeControlMode = 0x28 // Time control mode, TIME 0x28 P2
+)
+
+// TimeMode is a time control mode.
+type TimeMode int
+
+// Time control modes
+const (
+	TimeModeFree     TimeMode = iota // Free
+	TimeModeRealTime                 // Real time
+	TimeModeOffset                   // Offset time
+	TimeModeUnique                   // Unique time
+)
+
+// TimingKind is a kind of timing event.
+type TimingKind int
+
+// Kinds of timing event
+const (
+	TimingWait         TimingKind = iota // TIME 0x20 P1
+	TimingMode                           // TIME 0x28 P2
+	TimingPresentation                   // TIME 0x29 P31 .. P3i F
+)
+
+// Timing is a timing event of captions by TIME. The controls of timing by
+// CSI, e.g. TCC, are not timing events, but only commands.
+type Timing struct {
+	// Offset is the offset in bytes of the decoded text where the event
+	// appears.
+	Offset int
+
+	// Kind is the kind of the event.
+	Kind TimingKind
+
+	// Duration is the waiting time of TimingWait, or the presentation time of
+	// TimingPresentation.
+	Duration time.Duration
+
+	// Mode is the time control mode of TimingMode.
+	Mode TimeMode
+
+	// Final is the final character of TimingPresentation.
+	Final byte
+}
+
+// Timing returns the timing event of the command of TIME.
+func (c Command) Timing() (Timing, bool) {
+	if c.Code != TIME {
+		return Timing{}, false
+	}
+	n := 4 // hours, minutes, seconds and milliseconds
+	if c.Final == TimeWait || c.Final == TimeControlMode {
+		n = 1
+	}
+	if len(c.Params) < n {
+		return Timing{}, false
+	}
+	t := Timing{Offset: c.Offset}
+	switch c.Final {
+	case TimeWait:
+		t.Kind = TimingWait
+		t.Duration = time.Duration(c.Params[0]) * 100 * time.Millisecond
+	case TimeControlMode:
+		t.Kind = TimingMode
+		t.Mode = TimeMode(c.Params[0])
+	default:
+		t.Kind = TimingPresentation
+		t.Final = c.Final
+		t.Duration = time.Duration(c.Params[0])*time.Hour +
+			time.Duration(c.Params[1])*time.Minute +
+			time.Duration(c.Params[2])*time.Second +
+			time.Duration(c.Params[3])*time.Millisecond
+	}
+	return t, true
+}
+
+// DecodeTimings decodes b like the decoder of XCSEncoding, and also returns
+// the timing events in order of appearance.
+func DecodeTimings(b []byte) (string, []Timing, error) {
+	s, cmds, err := DecodeCommands(b)
+	if err != nil {
+		return "", nil, err
+	}
+	var timings []Timing
+	for _, cmd := range cmds {
+		if t, ok := cmd.Timing(); ok {
+			timings = append(timings, t)
+		}
+	}
+	return s, timings, nil
+}
+
+// readTIME reads the control sequence introduced by TIME and reports its
+// size. The parameters of the command are the waiting time in units of 0.1
+// seconds, the time control mode, or the hours, minutes, seconds and
+// milliseconds of the presentation time.
+//
+//	TIME 0x20 P1
+//	TIME 0x28 P2
+//	TIME 0x29 P31 .. P3i F
+func (d *xcsDecoder) readTIME(pos int) (Command, int, error) {
+	cmd := Command{Offset: d.written, Code: TIME}
+	if pos+2 >= len(d.buf) {
+		return cmd, 1, transform.ErrShortSrc
+	}
+	switch p := d.buf[pos+2]; d.buf[pos+1] {
+	case TimeWait:
+		if p < 0x40 || p > 0x7F {
+			return cmd, 3, fmt.Errorf("arib: TIME has invalid waiting time 0x%02X", p)
+		}
+		cmd.Final, cmd.Params = TimeWait, []int{int(p - 0x40)}
+		return cmd, 3, nil
+	case TimeControlMode:
+		if p < 0x40 || p > 0x43 {
+			return cmd, 3, fmt.Errorf("arib: TIME has invalid time control mode 0x%02X", p)
+		}
+		cmd.Final, cmd.Params = TimeControlMode, []int{int(p - 0x40)}
+		return cmd, 3, nil
+	case 0x29:
+		var digits []int
+		for i := pos + 2; i < len(d.buf); i++ {
+			switch b := d.buf[i]; {
+			case b >= 0x30 && b <= 0x39:
+				digits = append(digits, int(b-0x30))
+			case b >= 0x40 && b <= 0x43:
+				// final character
+				if len(digits) != 6 && len(digits) != 9 {
+					return cmd, i - pos + 1, fmt.Errorf("arib: TIME has invalid presentation time")
+				}
+				cmd.Params = []int{
+					digits[0]*10 + digits[1],
+					digits[2]*10 + digits[3],
+					digits[4]*10 + digits[5],
+					0,
+				}
+				if len(digits) == 9 {
+					cmd.Params[3] = digits[6]*100 + digits[7]*10 + digits[8]
+				}
+				cmd.Final = b
+				return cmd, i - pos + 1, nil
+			default:
+				return cmd, 1, fmt.Errorf("arib: TIME has invalid parameter 0x%02X", b)
+			}
+		}
+		return cmd, 1, transform.ErrShortSrc
+	}
+	return cmd, 2, fmt.Errorf("arib: TIME has invalid parameter 0x%02X", d.buf[pos+1])
+}
@@ -0,0 +1,109 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/transform"
+)
+
+func TestDecodeTimings(t *testing.T) {
+	for i, tc := range []struct {
+		src     []byte
+		dst     string
+		timings []Timing
+	}{
+		{
+			[]byte{0xA2, TIME, 0x20, 0x4F, 0xA4},
+			"あい",
+			[]Timing{{Offset: 3, Kind: TimingWait, Duration: 1500 * time.Millisecond}},
+		},
+		{
+			[]byte{TIME, 0x28, 0x41, 0xA2},
+			"あ",
+			[]Timing{{Offset: 0, Kind: TimingMode, Mode: TimeModeRealTime}},
+		},
+		{
+			[]byte{TIME, 0x28, 0x43, 0xA2},
+			"あ",
+			[]Timing{{Offset: 0, Kind: TimingMode, Mode: TimeModeUnique}},
+		},
+		{
+			[]byte{TIME, 0x29, 0x30, 0x31, 0x32, 0x30, 0x34, 0x35, 0x40, 0xA2, TIME, 0x29, 0x30, 0x31, 0x32, 0x30, 0x34, 0x35, 0x35, 0x30, 0x30, 0x41},
+			"あ",
+			[]Timing{
+				{Offset: 0, Kind: TimingPresentation, Duration: time.Hour + 20*time.Minute + 45*time.Second, Final: 0x40},
+				{Offset: 3, Kind: TimingPresentation, Duration: time.Hour + 20*time.Minute + 45*time.Second + 500*time.Millisecond, Final: 0x41},
+			},
+		},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			dst, timings, err := DecodeTimings(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dst != tc.dst || !reflect.DeepEqual(timings, tc.timings) {
+				t.Errorf("%d: DecodeTimings(0x%X) => %s, %+v, want %s, %+v", i, tc.src, dst, timings, tc.dst, tc.timings)
+			}
+		})
+	}
+}
+
+func TestCommandTimingInvalid(t *testing.T) {
+	for i, cmd := range []Command{
+		{Code: CSI, Final: 0x20, Params: []int{1}},
+		{Code: TIME, Final: TimeWait},
+		{Code: TIME, Final: TimeControlMode, Params: []int{}},
+		{Code: TIME, Final: 0x40, Params: []int{1, 20, 45}},
+	} {
+		if got, ok := cmd.Timing(); ok {
+			t.Errorf("%d: %+v.Timing() => %+v, true, want false", i, cmd, got)
+		}
+	}
+}
+
+func TestReadTIMEError(t *testing.T) {
+	for i, tc := range []struct {
+		src      []byte
+		size     int
+		shortSrc bool
+	}{
+		{[]byte{TIME, 0x20, 0x3F}, 3, false},
+		{[]byte{TIME, 0x28, 0x44}, 3, false},
+		{[]byte{TIME, 0x29, 0x31, 0x40}, 4, false},
+		{[]byte{TIME, 0x29, 0x31, 0x7E}, 1, false},
+		{[]byte{TIME, 0x30, 0x40}, 2, false},
+		{[]byte{TIME, 0x20}, 1, true},
+		{[]byte{TIME, 0x29, 0x31, 0x32}, 1, true},
+	} {
+		i, tc := i, tc
+		t.Run("", func(t *testing.T) {
+			t.Parallel()
+
+			d := newXCSDecoder()
+			d.buf = tc.src
+			_, size, err := d.readTIME(0)
+			if err == nil || (err == transform.ErrShortSrc) != tc.shortSrc || size != tc.size {
+				t.Errorf("%d: readTIME(0x%X) => %d, %v, want %d and error (short src %t)", i, tc.src, size, err, tc.size, tc.shortSrc)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"fmt"
+
+	"github.com/drillbits/go-arib/arib/xcs/graphicset"
+	"golang.org/x/text/transform"
+)
+
+// Kind is a kind of code.
+type Kind int
+
+// Kinds of code
+const (
+	KindControl Kind = iota // control code in C0 or C1
+	KindESC                 // escape sequence
+	KindSS                  // single shift, SS2 or SS3
+	KindGL                  // graphic character in GL
+	KindGR                  // graphic character in GR
+)
+
+var kindNames = [...]string{"control", "ESC", "SS", "GL", "GR"}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+	return kindNames[k]
+}
+
+// Trace is a record of a code read by the decoder.
+type Trace struct {
+	// Offset is the offset in bytes of the code in the source.
+	Offset int
+
+	// Bytes is the code.
+	Bytes []byte
+
+	// Kind is the kind of the code.
+	Kind Kind
+
+	// G is the index of G of the graphic set which decodes a graphic
+	// character, or -1 for the other kinds.
+	G int
+
+	// State is the state of the decoder after the code.
+	State State
+
+	// Text is the output of the code.
+	Text string
+
+	// Err is the error of the code replaced in lenient decoding.
+	Err error
+}
+
+// DecodeTrace decodes b with the options, and returns the records of the
+// codes in order of the source. It returns the records until the error if b
+// is not decodable.
+func DecodeTrace(b []byte, opts ...Option) ([]Trace, error) {
+	var traces []Trace
+	d := newXCSDecoder()
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.init()
+	d.onTrace = func(t Trace) {
+		traces = append(traces, t)
+	}
+	_, _, err := transform.Bytes(d, b)
+	return traces, err
+}
+
+// trace returns the record of the code at pos decoded from the state.
+func (d *xcsDecoder) trace(pos, size int, buf []byte, from decoderState, replaced *DecodeError) Trace {
+	t := Trace{
+		Offset: d.offset + pos,
+		Bytes:  append([]byte(nil), d.buf[pos:pos+size]...),
+		G:      -1,
+		State:  d.state(),
+		Text:   string(buf),
+	}
+	if replaced != nil {
+		t.Err = replaced
+	}
+	switch b := d.buf[pos]; {
+	case b == ESC:
+		t.Kind = KindESC
+	case b == SS2 || b == SS3:
+		t.Kind = KindSS
+	case b <= SP || b >= DEL && b <= CC1000 || b == CC1515:
+		t.Kind = KindControl
+	case b < DEL:
+		t.Kind = KindGL
+		t.G = from.gl
+		if from.SS != nil {
+			t.G = from.ss
+		}
+	default:
+		t.Kind = KindGR
+		t.G = from.gr
+	}
+	return t
+}
+
+// finalNames is the names of the final bytes of graphic sets.
+var finalNames = map[byte]string{
+	graphicset.Hiragana:         "Hiragana",
+	graphicset.Katakana:         "Katakana",
+	graphicset.MosaicA:          "MosaicA",
+	graphicset.MosaicB:          "MosaicB",
+	graphicset.MosaicC:          "MosaicC",
+	graphicset.MosaicD:          "MosaicD",
+	graphicset.PropAlphanumeric: "PropAlphanumeric",
+	graphicset.PropHiragana:     "PropHiragana",
+	graphicset.PropKatakana:     "PropKatakana",
+	graphicset.JISKanji1:        "JISKanji1",
+	graphicset.JISKanji2:        "JISKanji2",
+	graphicset.Symbols:          "Symbols",
+	graphicset.Kanji:            "Kanji",
+	graphicset.JISX0201Katakana: "JISX0201Katakana",
+	graphicset.Alphanumeric:     "Alphanumeric",
+	graphicset.LatinExtension:   "LatinExtension",
+}
+
+// String returns the name of the graphic set, e.g. "Kanji" or "DRCS-1".
+func (d Designation) String() string {
+	if d.DRCS {
+		switch {
+		case d.Final == graphicset.Macro:
+			return "Macro"
+		case d.Final >= graphicset.DRCS[0] && d.Final <= graphicset.DRCS[15]:
+			return fmt.Sprintf("DRCS-%d", d.Final-graphicset.DRCS[0])
+		}
+	} else if name, ok := finalNames[d.Final]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%02X", d.Final)
+}
@@ -0,0 +1,86 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/drillbits/go-arib/arib/xcs/graphicset"
+)
+
+func TestDecodeTrace(t *testing.T) {
+	// あ ESC ( J A SS2 い 【字】 CSI
+	src := []byte{0xA2, 0x1B, 0x28, 0x4A, 0x41, 0x19, 0x24, 0x7A, 0x56, 0x9B, 0x31, 0x20, 0x58}
+	afterESC := initialState
+	afterESC.G[0] = Designation{Final: graphicset.Alphanumeric}
+
+	want := []Trace{
+		{Offset: 0, Bytes: []byte{0xA2}, Kind: KindGR, G: 2, State: initialState, Text: "あ"},
+		{Offset: 1, Bytes: []byte{0x1B, 0x28, 0x4A}, Kind: KindESC, G: -1, State: afterESC},
+		{Offset: 4, Bytes: []byte{0x41}, Kind: KindGL, G: 0, State: afterESC, Text: "Ａ"},
+		{Offset: 5, Bytes: []byte{0x19}, Kind: KindSS, G: -1, State: afterESC},
+		{Offset: 6, Bytes: []byte{0x24}, Kind: KindGL, G: 2, State: afterESC, Text: "い"},
+		{Offset: 7, Bytes: []byte{0x7A}, Kind: KindGL, G: 0, State: afterESC, Text: "ｚ"},
+		{Offset: 8, Bytes: []byte{0x56}, Kind: KindGL, G: 0, State: afterESC, Text: "Ｖ"},
+		{Offset: 9, Bytes: []byte{0x9B, 0x31, 0x20, 0x58}, Kind: KindControl, G: -1, State: afterESC},
+	}
+	got, err := DecodeTrace(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("DecodeTrace(0x%X) => %d records, want %d", src, len(got), len(want))
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.Offset != w.Offset || !bytes.Equal(g.Bytes, w.Bytes) || g.Kind != w.Kind || g.G != w.G || g.State != w.State || g.Text != w.Text || g.Err != nil {
+			t.Errorf("%d: DecodeTrace(0x%X) => %+v, want %+v", i, src, g, w)
+		}
+	}
+}
+
+func TestDecodeTraceError(t *testing.T) {
+	src := []byte{0xA2, 0xFF, 0xA4}
+	got, err := DecodeTrace(src)
+	if err == nil || len(got) != 1 {
+		t.Errorf("DecodeTrace(0x%X) => %d records, %v, want 1 record and error", src, len(got), err)
+	}
+
+	got, err = DecodeTrace(src, WithLenient(ReplacementChar))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[1].Text != ReplacementChar || !errors.Is(got[1].Err, errInvalidARIBXCS) {
+		t.Errorf("DecodeTrace(0x%X) => %+v, want the replaced record", src, got)
+	}
+}
+
+func TestDesignationString(t *testing.T) {
+	for i, tc := range []struct {
+		d    Designation
+		want string
+	}{
+		{Designation{Final: graphicset.Kanji}, "Kanji"},
+		{Designation{Final: 0x41, DRCS: true}, "DRCS-1"},
+		{Designation{Final: graphicset.Macro, DRCS: true}, "Macro"},
+		{Designation{Final: 0x7A}, "0x7A"},
+	} {
+		if got := tc.d.String(); got != tc.want {
+			t.Errorf("%d: %#v.String() => %s, want %s", i, tc.d, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// Width is a normalization of character widths by the decoder.
+type Width int
+
+// Width normalizations
+const (
+	// WidthBroadcast keeps the widths as broadcast, where characters in
+	// middle or small size are half-width.
+	WidthBroadcast Width = iota
+
+	// WidthNarrowASCII folds full-width ASCII characters and the ideographic
+	// space to half-width.
+	WidthNarrowASCII
+
+	// WidthWideKana folds half-width katakana to full-width, composing voiced
+	// sound marks.
+	WidthWideKana
+)
+
+// transformer returns the transformer of the normalization, or nil for
+// WidthBroadcast.
+func (w Width) transformer() transform.Transformer {
+	switch w {
+	case WidthNarrowASCII:
+		return &narrowASCII{}
+	case WidthWideKana:
+		return &wideKana{}
+	}
+	return nil
+}
+
+// narrow returns b with the characters in half-width if they have it.
+// Katakana with voiced sound marks are decomposed to half-width ones.
+func narrow(b []byte) []byte {
+	var buf []byte
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+		if n := narrowRune(r); n != 0 {
+			buf = append(buf, string(n)...)
+			continue
+		}
+		d := []rune(norm.NFD.String(string(r)))
+		if len(d) == 2 && narrowRune(d[0]) != 0 && narrowRune(d[1]) != 0 {
+			buf = append(buf, string([]rune{narrowRune(d[0]), narrowRune(d[1])})...)
+			continue
+		}
+		buf = append(buf, string(r)...)
+	}
+	return buf
+}
+
+// narrowRune returns the half-width character of r, or 0 if it does not have
+// one.
+func narrowRune(r rune) rune {
+	switch r {
+	case '゛', '\u3099':
+		return halfVoiced
+	case '゜', '\u309A':
+		return halfSemiVoiced
+	}
+	return width.LookupRune(r).Narrow()
+}
+
+// wideRune returns the full-width character which narrowRune returns r for,
+// or 0 if r is not a half-width character.
+func wideRune(r rune) rune {
+	switch r {
+	case halfVoiced:
+		return '゛'
+	case halfSemiVoiced:
+		return '゜'
+	}
+	w := width.LookupRune(r).Wide()
+	if w == 0 || narrowRune(w) != r {
+		return 0
+	}
+	return w
+}
+
+type narrowASCII struct{ transform.NopResetter }
+
+func (t *narrowASCII) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if !utf8.FullRune(src[nSrc:]) && !atEOF {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		r, size := utf8.DecodeRune(src[nSrc:])
+		var buf []byte
+		switch {
+		case r == '　':
+			buf = []byte{' '}
+		case r >= '！' && r <= '～':
+			buf = []byte{byte(r - '！' + '!')}
+		default:
+			buf = src[nSrc : nSrc+size]
+		}
+		if nDst+len(buf) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], buf)
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+type wideKana struct{ transform.NopResetter }
+
+// Half-width voiced sound marks
+const (
+	halfVoiced     = 'ﾞ'
+	halfSemiVoiced = 'ﾟ'
+)
+
+func (t *wideKana) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if !utf8.FullRune(src[nSrc:]) && !atEOF {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		r, size := utf8.DecodeRune(src[nSrc:])
+		if r < '｡' || r > 'ﾟ' {
+			if nDst+size > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+			nSrc += size
+			continue
+		}
+
+		wide := width.LookupRune(r).Wide()
+		rest := src[nSrc+size:]
+		if !atEOF && !utf8.FullRune(rest) {
+			// the voiced sound mark may follow in the next src
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		if mark, n := utf8.DecodeRune(rest); n > 0 {
+			if c, ok := composeKana(wide, mark); ok {
+				wide = c
+				size += n
+			}
+		}
+		if nDst+utf8.RuneLen(wide) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], wide)
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// composeKana returns the full-width katakana with the half-width voiced sound
+// mark.
+func composeKana(r, mark rune) (rune, bool) {
+	switch mark {
+	case halfVoiced:
+		switch {
+		case r == 'ウ':
+			return 'ヴ', true
+		case r >= 'カ' && r <= 'チ' && (r-'カ')%2 == 0:
+			return r + 1, true
+		case r >= 'ツ' && r <= 'ト' && (r-'ツ')%2 == 0:
+			return r + 1, true
+		case r >= 'ハ' && r <= 'ホ' && (r-'ハ')%3 == 0:
+			return r + 1, true
+		}
+	case halfSemiVoiced:
+		if r >= 'ハ' && r <= 'ホ' && (r-'ハ')%3 == 0 {
+			return r + 2, true
+		}
+	}
+	return r, false
+}
@@ -0,0 +1,94 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package xcs
+
+import (
+	"testing"
+
+	"golang.org/x/text/transform"
+)
+
+func TestDecodeWidth(t *testing.T) {
+	for i, tc := range []struct {
+		name  string
+		width Width
+		src   []byte
+		dst   string
+	}{
+		{
+			name:  "Space",
+			width: WidthBroadcast,
+			src:   []byte{0xA2, 0x20, 0x89, 0xA2, 0x20, 0x8A, 0xA2, 0x20},
+			dst:   "あ　あ あ　",
+		},
+		{
+			name:  "MiddleSize",
+			width: WidthBroadcast,
+			src:   []byte{0x89, 0x0E, 0x41, 0x42, 0x8A, 0x43, 0x1B, 0x7C, 0x89, 0xA2, 0xAC, 0xD1},
+			dst:   "ABＣｱｶﾞﾊﾟ",
+		},
+		{
+			name:  "JISX0201Katakana",
+			width: WidthBroadcast,
+			src:   []byte{0x1B, 0x29, 0x49, 0x0E, 0x36, 0x5E, 0x4E, 0x5F, 0x21},
+			dst:   "ｶﾞﾎﾟ｡",
+		},
+		{
+			name:  "NarrowASCII",
+			width: WidthNarrowASCII,
+			src:   []byte{0x0E, 0x45, 0x1D, 0x46, 0x1D, 0x6C, 0x20, 0x32, 0x33, 0x35, 0x35},
+			dst:   "Eテレ 2355",
+		},
+		{
+			name:  "WideKana",
+			width: WidthWideKana,
+			src:   []byte{0x1B, 0x29, 0x49, 0x0E, 0x36, 0x5E, 0x4E, 0x5F, 0x33, 0x5E, 0x21},
+			dst:   "ガポヴ。",
+		},
+	} {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dec := NewDecoder(WithWidth(tc.width))
+			got, err := dec.Bytes(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.dst {
+				t.Errorf("%d: Decode(0x%X) => %s, want %s", i, tc.src, got, tc.dst)
+			}
+		})
+	}
+}
+
+func TestWideKanaShortSrc(t *testing.T) {
+	src := []byte("ｶﾞｷﾞ")
+	for n := 1; n < len(src); n++ {
+		var dst [32]byte
+		tr := &wideKana{}
+		nDst, nSrc, err := tr.Transform(dst[:], src[:n], false)
+		if n%6 != 0 && err != transform.ErrShortSrc {
+			t.Fatalf("Transform(%q, false) => _, _, %v, want _, _, %v", src[:n], err, transform.ErrShortSrc)
+		}
+		got, _, err := transform.String(&wideKana{}, string(src[nSrc:]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := string(dst[:nDst]) + got; s != "ガギ" {
+			t.Errorf("Transform(%q) => %s, want ガギ", src[:n], s)
+		}
+	}
+}
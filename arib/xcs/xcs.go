@@ -14,7 +14,11 @@
 
 package xcs
 
-import "golang.org/x/text/encoding"
+import (
+	"github.com/drillbits/go-arib/arib/xcs/graphicset"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
 
 // Control codes
 const (
@@ -80,6 +84,9 @@ var XCSEncoding encoding.Encoding = &Encoding{
 type Encoding struct {
 	decoder func() *encoding.Decoder
 	encoder func() *encoding.Encoder
+
+	// options is the options of the decoder.
+	options []Option
 }
 
 // NewDecoder returns a Decoder.
@@ -87,7 +94,91 @@ func (e *Encoding) NewDecoder() *encoding.Decoder {
 	return e.decoder()
 }
 
+// NewLenientDecoder returns a Decoder which replaces invalid codes with
+// ReplacementChar instead of returning an error.
+func (e *Encoding) NewLenientDecoder() *encoding.Decoder {
+	opts := append([]Option(nil), e.options...)
+	return NewDecoder(append(opts, WithLenient(ReplacementChar))...)
+}
+
 // NewEncoder returns an Encoder.
 func (e *Encoding) NewEncoder() *encoding.Encoder {
 	return e.encoder()
 }
+
+// Option is an option of the decoder.
+type Option func(d *xcsDecoder)
+
+// WithDRCS returns an Option which decodes DRCS characters by the store.
+// Without it, DRCS characters are decoded to graphicset.DefaultPlaceholder.
+func WithDRCS(s *graphicset.DRCSStore) Option {
+	return func(d *xcsDecoder) {
+		d.drcs = s
+	}
+}
+
+// WithMosaicFunc returns an Option which decodes mosaic characters to the
+// text returned by fn instead of block elements, e.g. to get the raw 2x3
+// patterns.
+func WithMosaicFunc(fn func(m graphicset.Mosaic) string) Option {
+	return func(d *xcsDecoder) {
+		d.mosaicText = fn
+	}
+}
+
+// WithLenient returns an Option which replaces invalid codes with the
+// replacement, e.g. ReplacementChar, and continues decoding instead of
+// returning a *DecodeError. Undefined characters are not invalid codes, see
+// WithUndefined.
+func WithLenient(replacement string) Option {
+	return func(d *xcsDecoder) {
+		d.lenient = true
+		d.replacement = replacement
+	}
+}
+
+// WithUndefined returns an Option which substitutes undefined characters with
+// the substitute instead of UndefinedChar, in both strict and lenient
+// decoding.
+func WithUndefined(substitute string) Option {
+	return func(d *xcsDecoder) {
+		d.undefined = substitute
+	}
+}
+
+// WithErrorFunc returns an Option which calls fn with the error of each code
+// replaced in lenient decoding, and of each undefined character substituted.
+func WithErrorFunc(fn func(err *DecodeError)) Option {
+	return func(d *xcsDecoder) {
+		d.onError = fn
+	}
+}
+
+// WithProfile returns an Option which maps the additional symbols by the
+// profile, e.g. graphicset.ProfileUnicode.
+func WithProfile(p *graphicset.Profile) Option {
+	return func(d *xcsDecoder) {
+		d.profile = p
+	}
+}
+
+// WithWidth returns an Option which normalizes character widths.
+func WithWidth(w Width) Option {
+	return func(d *xcsDecoder) {
+		d.width = w
+	}
+}
+
+// NewDecoder returns a Decoder of the ARIB external character set with the
+// options.
+func NewDecoder(opts ...Option) *encoding.Decoder {
+	d := newXCSDecoder()
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.init()
+	if t := d.width.transformer(); t != nil {
+		return &encoding.Decoder{Transformer: transform.Chain(d, t)}
+	}
+	return &encoding.Decoder{Transformer: d}
+}
@@ -0,0 +1,119 @@
+//    Copyright 2017 drillbits
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Command xcsdump prints the codes of ARIB external character set strings
+// with the states of the decoder.
+//
+// Usage:
+//
+//	xcsdump [-latin] [-lenient] [hex ...]
+//
+// The hex strings are read from the arguments, or from the lines of the
+// standard input if no argument is given. Spaces and a "0x" prefix are
+// ignored.
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/drillbits/go-arib/arib/xcs"
+)
+
+var (
+	latin   = flag.Bool("latin", false, "decode the Latin character set of ISDB-Tb")
+	lenient = flag.Bool("lenient", false, "replace invalid codes and continue")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: xcsdump [-latin] [-lenient] [hex ...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var opts []xcs.Option
+	if *latin {
+		opts = append(opts, xcs.WithLatin())
+	}
+	if *lenient {
+		opts = append(opts, xcs.WithLenient(xcs.ReplacementChar))
+	}
+
+	ok := true
+	dump := func(s string) {
+		if err := dumpHex(os.Stdout, s, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "xcsdump: %v\n", err)
+			ok = false
+		}
+	}
+	if flag.NArg() > 0 {
+		for _, s := range flag.Args() {
+			dump(s)
+		}
+	} else {
+		s := bufio.NewScanner(os.Stdin)
+		for s.Scan() {
+			if strings.TrimSpace(s.Text()) != "" {
+				dump(s.Text())
+			}
+		}
+		if err := s.Err(); err != nil {
+			fmt.Fprintf(os.Stderr, "xcsdump: %v\n", err)
+			ok = false
+		}
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+// dumpHex writes the codes of the hex string to w.
+func dumpHex(w io.Writer, s string, opts []xcs.Option) error {
+	s = strings.Join(strings.Fields(s), "")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+
+	traces, decodeErr := xcs.DecodeTrace(b, opts...)
+	tw := tabwriter.NewWriter(w, 0, 8, 1, ' ', 0)
+	fmt.Fprintln(tw, "OFFSET\tBYTES\tKIND\tG0\tG1\tG2\tG3\tGL\tGR\tTEXT")
+	for _, t := range traces {
+		kind := t.Kind.String()
+		if t.G >= 0 {
+			kind += fmt.Sprintf("(G%d)", t.G)
+		}
+		text := fmt.Sprintf("%q", t.Text)
+		if t.Err != nil {
+			text += fmt.Sprintf(" (%v)", t.Err)
+		}
+		fmt.Fprintf(tw, "%d\t% X\t%s\t%v\t%v\t%v\t%v\tG%d\tG%d\t%s\n",
+			t.Offset, t.Bytes, kind,
+			t.State.G[0], t.State.G[1], t.State.G[2], t.State.G[3],
+			t.State.GL, t.State.GR, text)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+	return decodeErr
+}